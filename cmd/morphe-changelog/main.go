@@ -0,0 +1,54 @@
+// Command morphe-changelog renders a Markdown changelog between two morphe registry directories.
+//
+// Each registry directory is expected to contain 'enums', 'models', 'structures' and 'entities' sub-directories.
+//
+//	morphe-changelog -before ./v1/registry -after ./v2/registry [-out CHANGELOG.md]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kalo-build/morphe-go/pkg/changelog"
+	"github.com/kalo-build/morphe-go/pkg/registry/cfg"
+)
+
+func main() {
+	beforeDirPath := flag.String("before", "", "registry directory of the previous version")
+	afterDirPath := flag.String("after", "", "registry directory of the new version")
+	outFilePath := flag.String("out", "", "file to write the changelog to, defaults to stdout")
+	flag.Parse()
+
+	if *beforeDirPath == "" || *afterDirPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	markdown, generateErr := changelog.GenerateFromConfigs(getRegistryConfig(*beforeDirPath), getRegistryConfig(*afterDirPath))
+	if generateErr != nil {
+		fmt.Fprintln(os.Stderr, generateErr)
+		os.Exit(1)
+	}
+
+	if *outFilePath == "" {
+		fmt.Print(markdown)
+		return
+	}
+	writeErr := os.WriteFile(*outFilePath, []byte(markdown), 0644)
+	if writeErr != nil {
+		fmt.Fprintln(os.Stderr, writeErr)
+		os.Exit(1)
+	}
+}
+
+func getRegistryConfig(registryDirPath string) cfg.MorpheLoadRegistryConfig {
+	return cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(registryDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(registryDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(registryDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(registryDirPath, "entities"),
+		RegistryMixinsDirPath:     filepath.Join(registryDirPath, "mixins"),
+	}
+}
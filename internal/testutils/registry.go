@@ -0,0 +1,19 @@
+package testutils
+
+import (
+	"path/filepath"
+
+	"github.com/kalo-build/morphe-go/pkg/registry/cfg"
+)
+
+// GetRegistryConfig returns the load config of a registry fixture in testdata/registry, such as 'records'
+func GetRegistryConfig(registryName string) cfg.MorpheLoadRegistryConfig {
+	registryDirPath := filepath.Join(GetTestDirPath(), "registry", registryName)
+	return cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(registryDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(registryDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(registryDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(registryDirPath, "entities"),
+		RegistryMixinsDirPath:     filepath.Join(registryDirPath, "mixins"),
+	}
+}
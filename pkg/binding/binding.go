@@ -0,0 +1,291 @@
+package binding
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// TagName is the struct tag that maps a Go struct field to a Morphe field, '-' excludes the struct field
+const TagName = "morphe"
+
+// TestingT is the subset of testing.TB used to report binding drift
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// fieldSpec is the type and attributes of a field, regardless of the definition kind it belongs to
+type fieldSpec struct {
+	Type       string
+	Attributes []string
+}
+
+// Options configures a binding check
+type Options struct {
+	// Kind selects the model, entity or structure of the name, it is required when several kinds share the name
+	Kind registry.DefinitionKind
+
+	// DisallowExtraFields reports struct fields that match no field of the definition.
+	// Struct fields named after a relation of the model or entity are always allowed.
+	DisallowExtraFields bool
+}
+
+// checker holds the registry definitions that field types refer to
+type checker struct {
+	options Options
+
+	enums      map[string]yaml.Enum
+	structures map[string]yaml.Structure
+}
+
+// Check verifies a Go type against the model, entity or structure with the specified name, a name shared by several kinds is ambiguous.
+// The target is a value, a pointer or a reflect.Type of a struct. It returns a *BindingError listing every mismatch.
+func Check(r *registry.Registry, definitionName string, target any) error {
+	return CheckWithOptions(r, definitionName, target, Options{})
+}
+
+// CheckWithOptions verifies a Go type against the model, entity or structure with the specified name, like Check
+func CheckWithOptions(r *registry.Registry, definitionName string, target any, options Options) error {
+	kind := options.Kind
+	if kind == "" {
+		definitionKind, kindErr := r.GetRecordDefinitionKind(definitionName)
+		if kindErr != nil {
+			return kindErr
+		}
+		kind = definitionKind
+	}
+
+	c := newChecker(r, options)
+	switch kind {
+	case registry.DefinitionKindModel:
+		return c.checkModel(r, definitionName, target)
+	case registry.DefinitionKindEntity:
+		return c.checkEntity(r, definitionName, target)
+	case registry.DefinitionKindStructure:
+		return c.checkStructure(definitionName, target)
+	}
+	return ErrUnsupportedDefinitionKind(kind)
+}
+
+// CheckModel verifies a Go type against a registry model
+func CheckModel(r *registry.Registry, modelName string, target any) error {
+	return newChecker(r, Options{}).checkModel(r, modelName, target)
+}
+
+// CheckEntity verifies a Go type against a registry entity, using the resolved type of each field path
+func CheckEntity(r *registry.Registry, entityName string, target any) error {
+	return newChecker(r, Options{}).checkEntity(r, entityName, target)
+}
+
+// CheckStructure verifies a Go type against a registry structure
+func CheckStructure(r *registry.Registry, structureName string, target any) error {
+	return newChecker(r, Options{}).checkStructure(structureName, target)
+}
+
+// AssertBinding reports each mismatch between a Go type and a definition as a test error, and returns true if they match
+func AssertBinding(t TestingT, r *registry.Registry, definitionName string, target any) bool {
+	t.Helper()
+	return AssertBindingWithOptions(t, r, definitionName, target, Options{})
+}
+
+// AssertBindingWithOptions reports each mismatch between a Go type and a definition as a test error, like AssertBinding
+func AssertBindingWithOptions(t TestingT, r *registry.Registry, definitionName string, target any, options Options) bool {
+	t.Helper()
+
+	checkErr := CheckWithOptions(r, definitionName, target, options)
+	if checkErr == nil {
+		return true
+	}
+	bindingErr, isBindingErr := checkErr.(*BindingError)
+	if !isBindingErr {
+		t.Errorf("%s", checkErr)
+		return false
+	}
+	for _, issue := range bindingErr.Issues {
+		t.Errorf("type '%s' does not match '%s': %s", bindingErr.GoType, bindingErr.Definition, issue.Error())
+	}
+	return false
+}
+
+func newChecker(r *registry.Registry, options Options) checker {
+	return checker{
+		options:    options,
+		enums:      r.GetAllEnums(),
+		structures: r.GetAllStructures(),
+	}
+}
+
+func (c checker) checkModel(r *registry.Registry, modelName string, target any) error {
+	model, modelErr := r.GetModel(modelName)
+	if modelErr != nil {
+		return modelErr
+	}
+	fields := make(map[string]fieldSpec, len(model.Fields))
+	for fieldName, field := range model.Fields {
+		fields[fieldName] = fieldSpec{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return c.check(modelName, fields, core.MapKeysSorted(model.Related), target)
+}
+
+func (c checker) checkEntity(r *registry.Registry, entityName string, target any) error {
+	entity, entityErr := r.GetEntity(entityName)
+	if entityErr != nil {
+		return entityErr
+	}
+	fields := make(map[string]fieldSpec, len(entity.Fields))
+	for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+		resolved, resolveErr := r.ResolveEntityFieldExpression(entityName, fieldName)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		fields[fieldName] = fieldSpec{Type: string(resolved.Type), Attributes: entity.Fields[fieldName].Attributes}
+	}
+	return c.check(entityName, fields, core.MapKeysSorted(entity.Related), target)
+}
+
+func (c checker) checkStructure(structureName string, target any) error {
+	if _, exists := c.structures[structureName]; !exists {
+		return ErrUnknownDefinition(structureName)
+	}
+	return c.check(structureName, c.getStructureFields(structureName), nil, target)
+}
+
+func (c checker) check(definitionName string, fields map[string]fieldSpec, relationNames []string, target any) error {
+	goType, isType := target.(reflect.Type)
+	if !isType {
+		goType = reflect.TypeOf(target)
+	}
+	for goType != nil && goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if goType == nil || goType.Kind() != reflect.Struct {
+		return ErrNotStruct(getTypeName(goType))
+	}
+
+	issues := c.checkFields("", definitionName, fields, relationNames, goType)
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Field < issues[j].Field
+	})
+	return &BindingError{
+		Definition: definitionName,
+		GoType:     getTypeName(goType),
+		Issues:     issues,
+	}
+}
+
+func (c checker) checkFields(pathPrefix string, definitionName string, fields map[string]fieldSpec, relationNames []string, goType reflect.Type) []BindingIssue {
+	issues := []BindingIssue{}
+	structFields := getStructFields(goType)
+	for _, fieldName := range core.MapKeysSorted(structFields) {
+		if !c.options.DisallowExtraFields || slices.Contains(relationNames, fieldName) {
+			continue
+		}
+		if _, exists := fields[fieldName]; !exists {
+			issues = append(issues, BindingIssue{
+				Field:   pathPrefix + fieldName,
+				Message: "is not a field of '" + definitionName + "' (struct field '" + structFields[fieldName].Name + "')",
+			})
+		}
+	}
+
+	for _, fieldName := range core.MapKeysSorted(fields) {
+		structField, exists := structFields[fieldName]
+		if !exists {
+			issues = append(issues, BindingIssue{Field: pathPrefix + fieldName, Message: "has no matching struct field"})
+			continue
+		}
+		issues = append(issues, c.checkField(pathPrefix+fieldName, fields[fieldName], structField.Type)...)
+	}
+	return issues
+}
+
+func (c checker) checkField(fieldPath string, field fieldSpec, fieldType reflect.Type) []BindingIssue {
+	issues := []BindingIssue{}
+	if !slices.Contains(field.Attributes, "mandatory") && !isNilable(fieldType) {
+		issues = append(issues, BindingIssue{Field: fieldPath, Message: "is optional, so its Go type " + getTypeName(fieldType) + " must be a pointer"})
+	}
+
+	baseType := fieldType
+	if baseType.Kind() == reflect.Pointer {
+		baseType = baseType.Elem()
+	}
+
+	if _, isStructure := c.structures[field.Type]; isStructure {
+		if baseType.Kind() == reflect.Map && baseType.Key().Kind() == reflect.String {
+			return issues
+		}
+		if baseType.Kind() != reflect.Struct {
+			return append(issues, newTypeIssue(fieldPath, field.Type, baseType))
+		}
+		return append(issues, c.checkFields(fieldPath+".", field.Type, c.getStructureFields(field.Type), nil, baseType)...)
+	}
+
+	if !c.isCompatible(field.Type, baseType) {
+		issues = append(issues, newTypeIssue(fieldPath, field.Type, baseType))
+	}
+	return issues
+}
+
+func (c checker) getStructureFields(structureName string) map[string]fieldSpec {
+	structure := c.structures[structureName]
+	fields := make(map[string]fieldSpec, len(structure.Fields))
+	for fieldName, field := range structure.Fields {
+		fields[fieldName] = fieldSpec{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return fields
+}
+
+// getStructFields maps the exported struct fields by Morphe field name, flattening untagged embedded structs like encoding/json
+func getStructFields(goType reflect.Type) map[string]reflect.StructField {
+	structFields := map[string]reflect.StructField{}
+	for i := 0; i < goType.NumField(); i++ {
+		structField := goType.Field(i)
+		tagName := strings.Split(structField.Tag.Get(TagName), ",")[0]
+		if tagName == "-" {
+			continue
+		}
+
+		embeddedType := structField.Type
+		if embeddedType.Kind() == reflect.Pointer {
+			embeddedType = embeddedType.Elem()
+		}
+		if structField.Anonymous && tagName == "" && embeddedType.Kind() == reflect.Struct {
+			for embeddedName, embeddedField := range getStructFields(embeddedType) {
+				if _, shadowed := structFields[embeddedName]; !shadowed {
+					structFields[embeddedName] = embeddedField
+				}
+			}
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldName := structField.Name
+		if tagName != "" {
+			fieldName = tagName
+		}
+		structFields[fieldName] = structField
+	}
+	return structFields
+}
+
+func newTypeIssue(fieldPath string, typeName string, goType reflect.Type) BindingIssue {
+	return BindingIssue{Field: fieldPath, Message: "has Go type " + getTypeName(goType) + ", which is not compatible with '" + typeName + "'"}
+}
+
+func getTypeName(goType reflect.Type) string {
+	if goType == nil {
+		return "nil"
+	}
+	return goType.String()
+}
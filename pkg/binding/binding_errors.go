@@ -0,0 +1,45 @@
+package binding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+// BindingIssue is a single mismatch between a Morphe field and a Go struct field
+type BindingIssue struct {
+	Field   string
+	Message string
+}
+
+func (i BindingIssue) Error() string {
+	return fmt.Sprintf("field '%s' %s", i.Field, i.Message)
+}
+
+// BindingError lists all mismatches between a definition and a Go type
+type BindingError struct {
+	Definition string
+	GoType     string
+	Issues     []BindingIssue
+}
+
+func (e *BindingError) Error() string {
+	issueMessages := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		issueMessages[i] = issue.Error()
+	}
+	return fmt.Sprintf("type '%s' does not match '%s': %s", e.GoType, e.Definition, strings.Join(issueMessages, "; "))
+}
+
+func ErrUnknownDefinition(name string) error {
+	return fmt.Errorf("no model, entity or structure with name '%s' found in registry", name)
+}
+
+func ErrUnsupportedDefinitionKind(kind registry.DefinitionKind) error {
+	return fmt.Errorf("definition kind '%s' cannot be bound, expected a model, entity or structure", kind)
+}
+
+func ErrNotStruct(goType string) error {
+	return fmt.Errorf("type '%s' is not a struct", goType)
+}
@@ -0,0 +1,189 @@
+package binding_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/binding"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+type Nationality string
+
+type Address struct {
+	Street  string
+	City    *string
+	ZipCode *int
+}
+
+type Credentials struct {
+	Username *string
+	Token    []byte
+}
+
+type Timestamps struct {
+	CreatedAt *time.Time `morphe:"CreatedAt"`
+}
+
+type Person struct {
+	Timestamps
+	ID          uint64
+	UUID        [16]byte
+	GivenName   string `morphe:"FirstName"`
+	LastName    *string
+	Age         *int
+	Salary      *float64
+	Active      *bool
+	BirthDate   *string
+	Nationality *Nationality
+	Level       *int64
+	Address     *Address
+	Password    *string
+	SSN         []byte
+	Credentials *Credentials
+	EmployerID  *int64
+	MentorID    *int64
+	Internal    string `morphe:"-"`
+	cache       string
+}
+
+type DriftedPerson struct {
+	Person
+	ID          string
+	Age         int
+	Nationality *int
+	Address     *struct{ Street int }
+	Nickname    *string
+}
+
+type Company struct {
+	ID   uint64
+	Name string
+}
+
+type PersonWithRelations struct {
+	Person
+	Employer *Company
+	Mentor   *Person
+}
+
+type PersonEntity struct {
+	ID           *int64
+	FirstName    string
+	FullName     *string
+	Nationality  *Nationality
+	SSN          *string
+	TaxID        *string
+	EmployerName *string
+	MentorName   *string
+	Email        *string
+	OrderCount   *int64
+	OrderTotal   *float64
+	MaxOrder     *float64
+}
+
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+type BindingTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+}
+
+func TestBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingTestSuite))
+}
+
+func (suite *BindingTestSuite) SetupTest() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	suite.Registry = r
+}
+
+func (suite *BindingTestSuite) TearDownTest() {
+	suite.Registry = nil
+}
+
+func (suite *BindingTestSuite) TestCheckModel_Matches() {
+	suite.NoError(binding.CheckModel(suite.Registry, "Person", Person{}))
+	suite.NoError(binding.CheckModel(suite.Registry, "Person", &Person{}))
+	suite.NoError(binding.CheckModel(suite.Registry, "Person", reflect.TypeOf(Person{})))
+}
+
+func (suite *BindingTestSuite) TestCheckModel_Drift() {
+	checkErr := binding.CheckModel(suite.Registry, "Person", DriftedPerson{})
+
+	var bindingErr *binding.BindingError
+	suite.Require().ErrorAs(checkErr, &bindingErr)
+	suite.Equal("binding_test.DriftedPerson", bindingErr.GoType)
+	suite.Equal([]binding.BindingIssue{
+		{Field: "Address.City", Message: "has no matching struct field"},
+		{Field: "Address.Street", Message: "has Go type int, which is not compatible with 'String'"},
+		{Field: "Address.ZipCode", Message: "has no matching struct field"},
+		{Field: "Age", Message: "is optional, so its Go type int must be a pointer"},
+		{Field: "ID", Message: "has Go type string, which is not compatible with 'AutoIncrement'"},
+		{Field: "Nationality", Message: "has Go type int, which is not compatible with 'Nationality'"},
+	}, bindingErr.Issues)
+}
+
+func (suite *BindingTestSuite) TestCheckWithOptions_DisallowExtraFields() {
+	options := binding.Options{Kind: registry.DefinitionKindModel, DisallowExtraFields: true}
+
+	driftErr := binding.CheckWithOptions(suite.Registry, "Person", DriftedPerson{}, options)
+	relationsErr := binding.CheckWithOptions(suite.Registry, "Person", PersonWithRelations{}, options)
+	structureErr := binding.CheckWithOptions(suite.Registry, "Address", struct {
+		Address
+		Country *string
+	}{}, binding.Options{DisallowExtraFields: true})
+
+	suite.ErrorContains(driftErr, "field 'Nickname' is not a field of 'Person' (struct field 'Nickname')")
+	suite.NoError(relationsErr)
+	suite.NoError(binding.CheckModel(suite.Registry, "Person", PersonWithRelations{}))
+	suite.ErrorContains(structureErr, "field 'Country' is not a field of 'Address' (struct field 'Country')")
+}
+
+func (suite *BindingTestSuite) TestCheck_Entity() {
+	suite.NoError(binding.CheckEntity(suite.Registry, "Person", PersonEntity{}))
+	suite.NoError(binding.CheckWithOptions(suite.Registry, "Person", PersonEntity{}, binding.Options{Kind: registry.DefinitionKindEntity}))
+	suite.ErrorContains(binding.CheckEntity(suite.Registry, "Person", struct{ ID *int64 }{}), "field 'FirstName' has no matching struct field")
+}
+
+func (suite *BindingTestSuite) TestCheck_Structure() {
+	suite.NoError(binding.Check(suite.Registry, "Address", Address{}))
+	suite.ErrorContains(binding.Check(suite.Registry, "Address", struct{ Street, City string }{}), "field 'City' is optional, so its Go type string must be a pointer")
+}
+
+func (suite *BindingTestSuite) TestCheck_Errors() {
+	suite.ErrorContains(binding.Check(suite.Registry, "Unknown", Person{}), "no model, entity or structure with name 'Unknown' found in registry")
+	suite.ErrorContains(binding.Check(suite.Registry, "Person", Person{}), "name 'Person' matches several definition kinds (model, entity), specify the definition kind")
+	suite.ErrorContains(binding.CheckModel(suite.Registry, "Person", "not a struct"), "type 'string' is not a struct")
+	suite.ErrorContains(binding.CheckModel(suite.Registry, "Person", nil), "type 'nil' is not a struct")
+	suite.ErrorContains(binding.CheckWithOptions(suite.Registry, "Nationality", Person{}, binding.Options{Kind: registry.DefinitionKindEnum}), "definition kind 'enum' cannot be bound, expected a model, entity or structure")
+}
+
+func (suite *BindingTestSuite) TestAssertBinding() {
+	recorder := &recordingT{}
+
+	suite.True(binding.AssertBindingWithOptions(recorder, suite.Registry, "Person", Person{}, binding.Options{Kind: registry.DefinitionKindModel}))
+	suite.True(binding.AssertBindingWithOptions(recorder, suite.Registry, "Person", PersonEntity{}, binding.Options{Kind: registry.DefinitionKindEntity}))
+	suite.False(binding.AssertBinding(recorder, suite.Registry, "Person", PersonEntity{}))
+	suite.False(binding.AssertBinding(recorder, suite.Registry, "Address", struct{ Street *string }{}))
+	suite.Equal([]string{
+		"name 'Person' matches several definition kinds (model, entity), specify the definition kind",
+		"type 'struct { Street *string }' does not match 'Address': field 'City' has no matching struct field",
+		"type 'struct { Street *string }' does not match 'Address': field 'ZipCode' has no matching struct field",
+	}, recorder.errors)
+}
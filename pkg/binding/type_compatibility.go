@@ -0,0 +1,63 @@
+package binding
+
+import (
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+var integerKinds = []reflect.Kind{
+	reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+	reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+}
+
+var floatKinds = []reflect.Kind{reflect.Float32, reflect.Float64}
+
+// isCompatible checks whether a non-pointer Go type can hold the values of a Morphe primitive or enum type
+func (c checker) isCompatible(typeName string, goType reflect.Type) bool {
+	if enum, isEnum := c.enums[typeName]; isEnum {
+		switch enum.Type {
+		case yaml.EnumTypeString:
+			return goType.Kind() == reflect.String
+		case yaml.EnumTypeInteger:
+			return slices.Contains(integerKinds, goType.Kind())
+		case yaml.EnumTypeFloat:
+			return slices.Contains(floatKinds, goType.Kind())
+		}
+		return false
+	}
+
+	switch yaml.ModelFieldType(typeName) {
+	case yaml.ModelFieldTypeUUID:
+		// Covers string UUIDs as well as [16]byte UUID types
+		return goType.Kind() == reflect.String || (goType.Kind() == reflect.Array && goType.Len() == 16 && goType.Elem().Kind() == reflect.Uint8)
+	case yaml.ModelFieldTypeAutoIncrement, yaml.ModelFieldTypeInteger:
+		return slices.Contains(integerKinds, goType.Kind())
+	case yaml.ModelFieldTypeFloat:
+		return slices.Contains(floatKinds, goType.Kind())
+	case yaml.ModelFieldTypeBoolean:
+		return goType.Kind() == reflect.Bool
+	case yaml.ModelFieldTypeString:
+		return goType.Kind() == reflect.String
+	case yaml.ModelFieldTypeProtected, yaml.ModelFieldTypeSealed:
+		return goType.Kind() == reflect.String || (goType.Kind() == reflect.Slice && goType.Elem().Kind() == reflect.Uint8)
+	case yaml.ModelFieldTypeTime:
+		return goType == timeType
+	case yaml.ModelFieldTypeDate:
+		return goType == timeType || goType.Kind() == reflect.String
+	}
+	return false
+}
+
+// isNilable checks whether a Go type can represent an unset optional value
+func isNilable(goType reflect.Type) bool {
+	switch goType.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return true
+	}
+	return false
+}
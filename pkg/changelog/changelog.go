@@ -0,0 +1,114 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registry/cfg"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+)
+
+// kindSections lists the changelog sections in order with their headings
+var kindSections = []struct {
+	Kind    registrydiff.DefinitionKind
+	Heading string
+}{
+	{Kind: registrydiff.DefinitionKindEnum, Heading: "Enums"},
+	{Kind: registrydiff.DefinitionKindModel, Heading: "Models"},
+	{Kind: registrydiff.DefinitionKindStructure, Heading: "Structures"},
+	{Kind: registrydiff.DefinitionKindEntity, Heading: "Entities"},
+}
+
+// GenerateFromConfigs loads the before and after registries and renders the Markdown changelog between them
+func GenerateFromConfigs(beforeConfig cfg.MorpheLoadRegistryConfig, afterConfig cfg.MorpheLoadRegistryConfig) (string, error) {
+	before, beforeErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, beforeConfig)
+	if beforeErr != nil {
+		return "", ErrLoadRegistry("before", beforeErr)
+	}
+	after, afterErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, afterConfig)
+	if afterErr != nil {
+		return "", ErrLoadRegistry("after", afterErr)
+	}
+	return Generate(before, after), nil
+}
+
+// Generate renders the Markdown changelog between two registries
+func Generate(before *registry.Registry, after *registry.Registry) string {
+	return Render(registrydiff.CheckCompatibility(before, after))
+}
+
+// Render renders a compatibility report as a Markdown changelog, grouped by definition kind and marked breaking or non-breaking
+func Render(report registrydiff.CompatibilityReport) string {
+	var changelog strings.Builder
+	changelog.WriteString("# Changelog\n\n")
+	if len(report.Changes) == 0 {
+		changelog.WriteString("No changes.\n")
+		return changelog.String()
+	}
+
+	breakingCount := len(report.GetBreaking())
+	fmt.Fprintf(&changelog, "%d breaking, %d non-breaking changes.\n", breakingCount, len(report.Changes)-breakingCount)
+
+	for _, section := range kindSections {
+		renderSection(&changelog, section.Heading, getKindChanges(report, section.Kind))
+	}
+	return changelog.String()
+}
+
+func getKindChanges(report registrydiff.CompatibilityReport, kind registrydiff.DefinitionKind) []registrydiff.ClassifiedChange {
+	kindChanges := []registrydiff.ClassifiedChange{}
+	for _, change := range report.Changes {
+		if change.Kind == kind {
+			kindChanges = append(kindChanges, change)
+		}
+	}
+	return kindChanges
+}
+
+// renderSection lists added and removed definitions first, followed by a sub-section per changed definition
+func renderSection(changelog *strings.Builder, heading string, changes []registrydiff.ClassifiedChange) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(changelog, "\n## %s\n", heading)
+
+	definitionLines := []string{}
+	changedDefinitions := []string{}
+	memberLines := map[string][]string{}
+	for _, change := range changes {
+		if change.Member == nil {
+			definitionLines = append(definitionLines, renderLine(change))
+			continue
+		}
+		if _, exists := memberLines[change.Definition]; !exists {
+			changedDefinitions = append(changedDefinitions, change.Definition)
+		}
+		memberLines[change.Definition] = append(memberLines[change.Definition], renderLine(change))
+	}
+
+	if len(definitionLines) > 0 {
+		changelog.WriteString("\n")
+		changelog.WriteString(strings.Join(definitionLines, "\n"))
+		changelog.WriteString("\n")
+	}
+	for _, definitionName := range changedDefinitions {
+		fmt.Fprintf(changelog, "\n### %s\n\n", definitionName)
+		changelog.WriteString(strings.Join(memberLines[definitionName], "\n"))
+		changelog.WriteString("\n")
+	}
+}
+
+func renderLine(change registrydiff.ClassifiedChange) string {
+	if change.Compatibility == registrydiff.CompatibilityBreaking {
+		return fmt.Sprintf("- **Breaking:** %s", capitalize(change.Reason))
+	}
+	return fmt.Sprintf("- Non-breaking: %s", capitalize(change.Reason))
+}
+
+func capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	return strings.ToUpper(text[:1]) + text[1:]
+}
@@ -0,0 +1,7 @@
+package changelog
+
+import "fmt"
+
+func ErrLoadRegistry(side string, loadErr error) error {
+	return fmt.Errorf("error loading %s registry: %w", side, loadErr)
+}
@@ -0,0 +1,101 @@
+package changelog_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/changelog"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registry/cfg"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func getRegistryConfig(registryName string) cfg.MorpheLoadRegistryConfig {
+	registryDirPath := filepath.Join(testutils.GetTestDirPath(), "registry", registryName)
+	return cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(registryDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(registryDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(registryDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(registryDirPath, "entities"),
+	}
+}
+
+func TestGenerate_NoChanges(t *testing.T) {
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American"}})
+
+	assert.Equal(t, "# Changelog\n\nNo changes.\n", changelog.Generate(r, r.DeepClone()))
+}
+
+func TestGenerate(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American", "FR": "French"}})
+	before.SetModel("Person", yaml.Model{
+		Name:   "Person",
+		Fields: map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}},
+	})
+	after := before.DeepClone()
+	after.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American"}})
+	after.SetModel("Company", yaml.Model{
+		Name:   "Company",
+		Fields: map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}},
+	})
+	after.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":    {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Email": {Type: yaml.ModelFieldTypeString},
+		},
+	})
+
+	expected := "# Changelog\n\n" +
+		"1 breaking, 2 non-breaking changes.\n" +
+		"\n## Enums\n" +
+		"\n### Nationality\n\n" +
+		"- **Breaking:** Enum entry 'FR' was removed\n" +
+		"\n## Models\n\n" +
+		"- Non-breaking: Model 'Company' was added\n" +
+		"\n### Person\n\n" +
+		"- Non-breaking: Field 'Email' was added\n"
+	assert.Equal(t, expected, changelog.Generate(before, after))
+}
+
+func TestGenerateFromConfigs(t *testing.T) {
+	markdown, generateErr := changelog.GenerateFromConfigs(getRegistryConfig("minimal"), getRegistryConfig("verbose"))
+
+	require.NoError(t, generateErr)
+	assert.Contains(t, markdown, "## Models")
+	assert.Contains(t, markdown, "- Non-breaking: Model 'Company' was added")
+	assert.Contains(t, markdown, "- **Breaking:** Field 'Nationality' was removed")
+	assert.Contains(t, markdown, "## Entities")
+}
+
+func TestGenerateFromConfigs_InvalidConfig(t *testing.T) {
+	beforeConfig := getRegistryConfig("minimal")
+	beforeConfig.SpecVersion = "0"
+
+	_, generateErr := changelog.GenerateFromConfigs(beforeConfig, getRegistryConfig("verbose"))
+
+	assert.ErrorContains(t, generateErr, "error loading before registry")
+}
+
+func TestGenerate_DeprecatedEnumEntry(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American", "FR": "French"}})
+	after := before.DeepClone()
+	after.SetEnum("Nationality", yaml.Enum{
+		Name:              "Nationality",
+		Type:              yaml.EnumTypeString,
+		Entries:           map[string]any{"US": "American", "FR": "French"},
+		DeprecatedEntries: map[string]yaml.Deprecation{"FR": {Since: "2.3"}},
+	})
+
+	markdown := changelog.Generate(before, after)
+
+	assert.Contains(t, markdown, "0 breaking, 1 non-breaking changes.")
+	assert.Contains(t, markdown, "### Nationality\n\n- Non-breaking: Entry 'FR' was deprecated (since 2.3)\n")
+}
@@ -0,0 +1,170 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+)
+
+// GenerateDataset generates the specified number of records per model and links them through their relations.
+// For relations pick their targets, and the records are added to the inverse Has relation of the target, so both sides agree.
+// Has relations without an inverse pick their own targets. Polymorphic relations are not linked.
+func (g *Generator) GenerateDataset(counts map[string]int) (Dataset, error) {
+	dataset := Dataset{}
+	for _, modelName := range core.MapKeysSorted(counts) {
+		count := counts[modelName]
+		if _, exists := g.models[modelName]; !exists {
+			return nil, ErrUnknownDefinition("model", modelName)
+		}
+		if count < 0 {
+			return nil, ErrInvalidRecordCount(modelName, count)
+		}
+
+		records := make([]Record, 0, count)
+		for i := 0; i < count; i++ {
+			record, recordErr := g.GenerateModel(modelName)
+			if recordErr != nil {
+				return nil, recordErr
+			}
+			records = append(records, record)
+		}
+		dataset[modelName] = records
+	}
+
+	// Inverse relations are linked while processing other models, so all relation lists exist upfront
+	for modelName, records := range dataset {
+		for relationName, relation := range g.models[modelName].Related {
+			if yamlops.IsRelationPoly(relation.Type) {
+				continue
+			}
+			for _, record := range records {
+				record.Related[relationName] = []any{}
+			}
+		}
+	}
+
+	for _, modelName := range core.MapKeysSorted(dataset) {
+		model := g.models[modelName]
+		for _, relationName := range core.MapKeysSorted(model.Related) {
+			linkErr := g.linkRelation(dataset, model, relationName)
+			if linkErr != nil {
+				return nil, linkErr
+			}
+		}
+	}
+	return dataset, nil
+}
+
+func (g *Generator) linkRelation(dataset Dataset, model yaml.Model, relationName string) error {
+	relation := model.Related[relationName]
+	if yamlops.IsRelationPoly(relation.Type) {
+		return nil
+	}
+
+	sources := dataset[model.Name]
+	targetName := yamlops.GetRelationTargetName(relationName, relation.Aliased)
+	targets := dataset[targetName]
+	if len(targets) == 0 {
+		if relation.Required && len(sources) > 0 {
+			return ErrRequiredRelationWithoutTarget(model.Name, relationName, targetName)
+		}
+		return nil
+	}
+
+	inverseName, inverse, hasInverse := g.findInverseRelation(targetName, model.Name, relation.Type)
+	if yamlops.IsRelationHas(relation.Type) {
+		if hasInverse {
+			// Linked from the For side
+			return nil
+		}
+		return g.linkHasRelation(model, sources, targets, relationName, relation)
+	}
+	return g.linkForRelation(model, sources, targets, relationName, relation, inverseName, inverse, hasInverse)
+}
+
+func (g *Generator) linkForRelation(model yaml.Model, sources []Record, targets []Record, relationName string, relation yaml.ModelRelation, inverseName string, inverse yaml.ModelRelation, hasInverse bool) error {
+	// An inverse HasOne allows each target to be linked to a single source
+	uniqueTargets := hasInverse && yamlops.IsRelationOne(inverse.Type) && yamlops.IsRelationOne(relation.Type)
+	unusedTargets := g.random.Perm(len(targets))
+
+	for _, source := range sources {
+		var targetIndexes []int
+		switch {
+		case uniqueTargets:
+			if len(unusedTargets) == 0 {
+				if relation.Required {
+					return ErrInsufficientRelationTargets(model.Name, relationName, targets[0].Definition)
+				}
+				continue
+			}
+			targetIndexes = unusedTargets[:1]
+			unusedTargets = unusedTargets[1:]
+		case yamlops.IsRelationMany(relation.Type):
+			targetIndexes = g.pickIndexes(len(targets))
+		default:
+			targetIndexes = []int{g.random.Intn(len(targets))}
+		}
+
+		for _, targetIndex := range targetIndexes {
+			target := targets[targetIndex]
+			source.Related[relationName] = append(source.Related[relationName], target.ID)
+			if hasInverse {
+				target.Related[inverseName] = append(target.Related[inverseName], source.ID)
+			}
+		}
+		if yamlops.IsRelationOne(relation.Type) && len(targetIndexes) == 1 {
+			setForeignKey(source, model, yamlops.GetForRelationForeignKey(relationName, relation), targets[targetIndexes[0]].ID)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) linkHasRelation(model yaml.Model, sources []Record, targets []Record, relationName string, relation yaml.ModelRelation) error {
+	targetModel := g.models[targets[0].Definition]
+	foreignKey, foreignKeyErr := yamlops.GetHasRelationForeignKey(model.Name, relation, targetModel)
+	if foreignKeyErr != nil {
+		return foreignKeyErr
+	}
+	for _, source := range sources {
+		targetIndexes := []int{g.random.Intn(len(targets))}
+		if yamlops.IsRelationMany(relation.Type) {
+			targetIndexes = g.pickIndexes(len(targets))
+		}
+		for _, targetIndex := range targetIndexes {
+			source.Related[relationName] = append(source.Related[relationName], targets[targetIndex].ID)
+			setForeignKey(targets[targetIndex], targetModel, foreignKey, source.ID)
+		}
+	}
+	return nil
+}
+
+// findInverseRelation finds the non-polymorphic relation of the target model that points back at the source model with the opposite direction
+func (g *Generator) findInverseRelation(targetName string, sourceName string, relationType string) (string, yaml.ModelRelation, bool) {
+	targetModel := g.models[targetName]
+	for _, inverseName := range core.MapKeysSorted(targetModel.Related) {
+		inverse := targetModel.Related[inverseName]
+		if yamlops.IsRelationPoly(inverse.Type) || yamlops.IsRelationFor(inverse.Type) == yamlops.IsRelationFor(relationType) {
+			continue
+		}
+		if yamlops.GetRelationTargetName(inverseName, inverse.Aliased) == sourceName {
+			return inverseName, inverse, true
+		}
+	}
+	return "", yaml.ModelRelation{}, false
+}
+
+// pickIndexes picks between one and MaxRelated distinct indexes, in ascending order
+func (g *Generator) pickIndexes(count int) []int {
+	maxPicked := min(count, g.options.MaxRelated)
+	picked := g.random.Perm(count)[:g.random.Intn(maxPicked)+1]
+	slices.Sort(picked)
+	return picked
+}
+
+func setForeignKey(record Record, model yaml.Model, foreignKey string, id any) {
+	if _, exists := model.Fields[foreignKey]; exists {
+		record.Fields[foreignKey] = id
+	}
+}
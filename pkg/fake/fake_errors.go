@@ -0,0 +1,27 @@
+package fake
+
+import "fmt"
+
+func ErrUnknownFieldType(definitionName string, fieldName string, fieldType string) error {
+	return fmt.Errorf("cannot generate value for '%s' field '%s': unknown type '%s'", definitionName, fieldName, fieldType)
+}
+
+func ErrInvalidRecordCount(modelName string, count int) error {
+	return fmt.Errorf("invalid record count %d for model '%s'", count, modelName)
+}
+
+func ErrRequiredRelationWithoutTarget(modelName string, relationName string, targetName string) error {
+	return fmt.Errorf("model '%s' requires relation '%s', but no '%s' records are generated", modelName, relationName, targetName)
+}
+
+func ErrUnknownDefinition(kind string, name string) error {
+	return fmt.Errorf("%s with name '%s' not found in registry", kind, name)
+}
+
+func ErrInsufficientRelationTargets(modelName string, relationName string, targetName string) error {
+	return fmt.Errorf("model '%s' requires relation '%s', but there are not enough unlinked '%s' records", modelName, relationName, targetName)
+}
+
+func ErrIdentifierValuesExhausted(definitionName string, identifierName string) error {
+	return fmt.Errorf("cannot generate a unique value for '%s' identifier '%s': its possible values are exhausted", definitionName, identifierName)
+}
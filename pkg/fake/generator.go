@@ -0,0 +1,190 @@
+package fake
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// DefaultMaxRelated is the default limit of records linked through a single Many relation
+const DefaultMaxRelated = 3
+
+// Options configures a Generator
+type Options struct {
+	// Seed makes generation reproducible: the same seed, registry and calls always produce the same records
+	Seed int64
+
+	// OmitOptionalFields leaves fields without the mandatory attribute unset
+	OmitOptionalFields bool
+
+	// MaxRelated limits the records linked through a single Many relation, defaults to DefaultMaxRelated
+	MaxRelated int
+}
+
+// Generator produces sample records for the definitions of a registry
+type Generator struct {
+	options Options
+	random  *rand.Rand
+
+	enums      map[string]yaml.Enum
+	models     map[string]yaml.Model
+	structures map[string]yaml.Structure
+	entities   map[string]yaml.Entity
+
+	// sequences holds the last generated sequence number of each model and entity
+	sequences map[string]int64
+
+	// identifierKeys holds the used identifier values of each model and entity, by identifier name
+	identifierKeys map[string]map[string]map[string]bool
+}
+
+// NewGenerator creates a generator from a snapshot of the registry definitions
+func NewGenerator(r *registry.Registry, options Options) *Generator {
+	if options.MaxRelated <= 0 {
+		options.MaxRelated = DefaultMaxRelated
+	}
+	return &Generator{
+		options:    options,
+		random:     rand.New(rand.NewSource(options.Seed)),
+		enums:      r.GetAllEnums(),
+		models:     r.GetAllModels(),
+		structures: r.GetAllStructures(),
+		entities:   r.GetAllEntities(),
+		sequences:  map[string]int64{},
+
+		identifierKeys: map[string]map[string]map[string]bool{},
+	}
+}
+
+// GenerateModel generates a single model record, without related records
+func (g *Generator) GenerateModel(modelName string) (Record, error) {
+	model, exists := g.models[modelName]
+	if !exists {
+		return Record{}, ErrUnknownDefinition("model", modelName)
+	}
+
+	fields := make(map[string]fieldSpec, len(model.Fields))
+	for fieldName, field := range model.Fields {
+		fields[fieldName] = fieldSpec{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return g.generateRecord(modelName, fields, model.Identifiers)
+}
+
+// GenerateEntity generates a single entity record, using the resolved type of each field path
+func (g *Generator) GenerateEntity(entityName string) (Record, error) {
+	entity, exists := g.entities[entityName]
+	if !exists {
+		return Record{}, ErrUnknownDefinition("entity", entityName)
+	}
+
+	fields := make(map[string]fieldSpec, len(entity.Fields))
+	for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+		resolved, resolveErr := entity.ResolveFieldExpression(fieldName, g.models, g.enums, g.structures)
+		if resolveErr != nil {
+			return Record{}, resolveErr
+		}
+		fields[fieldName] = fieldSpec{Type: string(resolved.Type), Attributes: entity.Fields[fieldName].Attributes}
+	}
+
+	entityIdentifiers := make(map[string]yaml.ModelIdentifier, len(entity.Identifiers))
+	for identifierName, identifier := range entity.Identifiers {
+		entityIdentifiers[identifierName] = yaml.ModelIdentifier{Fields: identifier.Fields}
+	}
+	return g.generateRecord(entityName, fields, entityIdentifiers)
+}
+
+// GenerateStructure generates a single structure record
+func (g *Generator) GenerateStructure(structureName string) (Record, error) {
+	if _, exists := g.structures[structureName]; !exists {
+		return Record{}, ErrUnknownDefinition("structure", structureName)
+	}
+
+	g.sequences[structureName]++
+	fieldValues, fieldsErr := g.generateStructureFields(structureName, g.sequences[structureName])
+	if fieldsErr != nil {
+		return Record{}, fieldsErr
+	}
+	return Record{
+		Definition: structureName,
+		Fields:     fieldValues,
+		Related:    map[string][]any{},
+	}, nil
+}
+
+// generateRecord generates the field values of a model or entity record, keeping the values of every identifier unique
+func (g *Generator) generateRecord(definitionName string, fields map[string]fieldSpec, identifiers map[string]yaml.ModelIdentifier) (Record, error) {
+	g.sequences[definitionName]++
+	sequence := g.sequences[definitionName]
+
+	fieldValues, fieldsErr := g.generateFields(definitionName, fields, getAllIdentifierFields(identifiers), sequence)
+	if fieldsErr != nil {
+		return Record{}, fieldsErr
+	}
+	identifiersErr := g.claimIdentifiers(definitionName, fields, identifiers, fieldValues, sequence)
+	if identifiersErr != nil {
+		return Record{}, identifiersErr
+	}
+	return Record{
+		Definition: definitionName,
+		ID:         getRecordID(fieldValues, getPrimaryIdentifierFields(identifiers)),
+		Fields:     fieldValues,
+		Related:    map[string][]any{},
+	}, nil
+}
+
+func (g *Generator) generateStructureFields(structureName string, sequence int64) (map[string]any, error) {
+	structure := g.structures[structureName]
+	fields := make(map[string]fieldSpec, len(structure.Fields))
+	for fieldName, field := range structure.Fields {
+		fields[fieldName] = fieldSpec{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return g.generateFields(structureName, fields, nil, sequence)
+}
+
+// generateFields generates the field values in sorted field order, so the random sequence is reproducible
+func (g *Generator) generateFields(definitionName string, fields map[string]fieldSpec, identifierFields []string, sequence int64) (map[string]any, error) {
+	fieldValues := map[string]any{}
+	for _, fieldName := range core.MapKeysSorted(fields) {
+		field := fields[fieldName]
+		isIdentifier := slices.Contains(identifierFields, fieldName)
+		if g.options.OmitOptionalFields && !isIdentifier && !slices.Contains(field.Attributes, "mandatory") {
+			continue
+		}
+
+		value, valueErr := g.generateValue(definitionName, fieldName, field.Type, isIdentifier, sequence)
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		fieldValues[fieldName] = value
+	}
+	return fieldValues, nil
+}
+
+// getPrimaryIdentifierFields returns the fields of the 'primary' identifier, or of the first identifier by name
+func getPrimaryIdentifierFields(identifiers map[string]yaml.ModelIdentifier) []string {
+	if primary, exists := identifiers["primary"]; exists {
+		return primary.Fields
+	}
+	identifierNames := core.MapKeysSorted(identifiers)
+	if len(identifierNames) == 0 {
+		return nil
+	}
+	return identifiers[identifierNames[0]].Fields
+}
+
+func getRecordID(fieldValues map[string]any, identifierFields []string) any {
+	switch len(identifierFields) {
+	case 0:
+		return nil
+	case 1:
+		return fieldValues[identifierFields[0]]
+	}
+	id := make([]any, len(identifierFields))
+	for i, fieldName := range identifierFields {
+		id[i] = fieldValues[fieldName]
+	}
+	return id
+}
@@ -0,0 +1,177 @@
+package fake_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/fake"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+type GeneratorTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+}
+
+func TestGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorTestSuite))
+}
+
+func (suite *GeneratorTestSuite) SetupTest() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	suite.Registry = r
+}
+
+func (suite *GeneratorTestSuite) TearDownTest() {
+	suite.Registry = nil
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_Reproducible() {
+	counts := map[string]int{"Person": 5, "Company": 2, "Passport": 3}
+
+	first, firstErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 42}).GenerateDataset(counts)
+	second, secondErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 42}).GenerateDataset(counts)
+	other, otherErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 7}).GenerateDataset(counts)
+
+	suite.Require().NoError(firstErr)
+	suite.Require().NoError(secondErr)
+	suite.Require().NoError(otherErr)
+	suite.Equal(first, second)
+	suite.NotEqual(first, other)
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_ValidRecords() {
+	dataset, datasetErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 1}).GenerateDataset(map[string]int{"Person": 10, "Company": 3})
+
+	suite.Require().NoError(datasetErr)
+	suite.Require().Len(dataset["Person"], 10)
+	ids := map[any]bool{}
+	for _, person := range dataset["Person"] {
+		suite.NoError(suite.Registry.ValidateModelRecord("Person", person.Fields))
+		suite.Equal(person.Fields["ID"], person.ID)
+		ids[person.ID] = true
+	}
+	suite.Len(ids, 10)
+	companyNames := map[any]bool{}
+	for _, company := range dataset["Company"] {
+		companyNames[company.Fields["Name"]] = true
+	}
+	suite.Len(companyNames, 3)
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_ConsistentRelations() {
+	dataset, datasetErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 3}).GenerateDataset(map[string]int{"Person": 6, "Company": 2, "Passport": 4})
+
+	suite.Require().NoError(datasetErr)
+	linkedPeople := 0
+	for _, person := range dataset["Person"] {
+		suite.Require().Len(person.Related["Employer"], 1)
+		companyID := person.Related["Employer"][0]
+		suite.Equal(companyID, person.Fields["EmployerID"])
+
+		company, companyFound := dataset.GetRecordByID("Company", companyID)
+		suite.Require().True(companyFound)
+		suite.Contains(company.Related["Employee"], person.ID)
+		linkedPeople += len(person.Related["Passport"])
+		suite.LessOrEqual(len(person.Related["Passport"]), 1)
+	}
+	companyPeople := 0
+	for _, company := range dataset["Company"] {
+		companyPeople += len(company.Related["Employee"])
+	}
+	suite.Equal(6, companyPeople)
+	suite.Equal(4, linkedPeople)
+
+	for _, passport := range dataset["Passport"] {
+		suite.Require().Len(passport.Related["Person"], 1)
+		person, personFound := dataset.GetRecordByID("Person", passport.Related["Person"][0])
+		suite.Require().True(personFound)
+		suite.Equal([]any{passport.ID}, person.Related["Passport"])
+	}
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_RequiredRelationWithoutTarget() {
+	_, datasetErr := fake.NewGenerator(suite.Registry, fake.Options{}).GenerateDataset(map[string]int{"Person": 1})
+
+	suite.ErrorContains(datasetErr, "model 'Person' requires relation 'Employer', but no 'Company' records are generated")
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_UnknownModel() {
+	_, datasetErr := fake.NewGenerator(suite.Registry, fake.Options{}).GenerateDataset(map[string]int{"Unknown": 1})
+
+	suite.ErrorContains(datasetErr, "model with name 'Unknown' not found in registry")
+}
+
+func (suite *GeneratorTestSuite) TestGenerateModel_OmitOptionalFields() {
+	person, personErr := fake.NewGenerator(suite.Registry, fake.Options{OmitOptionalFields: true}).GenerateModel("Person")
+
+	suite.Require().NoError(personErr)
+	suite.ElementsMatch([]string{"ID", "UUID", "FirstName", "LastName"}, getFieldNames(person))
+	suite.Equal(int64(1), person.ID)
+	suite.NoError(suite.Registry.ValidateModelRecord("Person", person.Fields))
+}
+
+func (suite *GeneratorTestSuite) TestGenerateDataset_UniqueIdentifiers() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("verbose"))
+	suite.Require().NoError(loadErr)
+
+	dataset, datasetErr := fake.NewGenerator(r, fake.Options{Seed: 1}).GenerateDataset(map[string]int{"Person": 30, "Company": 3})
+
+	suite.Require().NoError(datasetErr)
+	uuids := map[any]bool{}
+	names := map[[2]any]bool{}
+	for _, person := range dataset["Person"] {
+		uuids[person.Fields["UUID"]] = true
+		names[[2]any{person.Fields["FirstName"], person.Fields["LastName"]}] = true
+	}
+	suite.Len(uuids, 30)
+	suite.Len(names, 30)
+}
+
+func (suite *GeneratorTestSuite) TestGenerateModel_UniqueEnumAndBooleanIdentifier() {
+	generator := fake.NewGenerator(suite.Registry, fake.Options{Seed: 9})
+
+	tiers := map[[2]any]bool{}
+	for i := 0; i < 4; i++ {
+		membership, membershipErr := generator.GenerateModel("Membership")
+		suite.Require().NoError(membershipErr)
+		tiers[[2]any{membership.Fields["Level"], membership.Fields["Active"]}] = true
+	}
+	_, exhaustedErr := generator.GenerateModel("Membership")
+
+	suite.Len(tiers, 4)
+	suite.ErrorContains(exhaustedErr, "cannot generate a unique value for 'Membership' identifier 'tier': its possible values are exhausted")
+}
+
+func (suite *GeneratorTestSuite) TestGenerateEntity() {
+	generator := fake.NewGenerator(suite.Registry, fake.Options{Seed: 5})
+
+	first, firstErr := generator.GenerateEntity("Person")
+	second, secondErr := generator.GenerateEntity("Person")
+
+	suite.Require().NoError(firstErr)
+	suite.Require().NoError(secondErr)
+	suite.Equal(int64(1), first.ID)
+	suite.Equal(int64(2), second.ID)
+	suite.NoError(suite.Registry.ValidateEntityRecord("Person", first.Fields))
+}
+
+func (suite *GeneratorTestSuite) TestGenerateStructure() {
+	address, addressErr := fake.NewGenerator(suite.Registry, fake.Options{Seed: 5}).GenerateStructure("Address")
+
+	suite.Require().NoError(addressErr)
+	suite.Nil(address.ID)
+	suite.NoError(suite.Registry.ValidateStructureRecord("Address", address.Fields))
+}
+
+func getFieldNames(record fake.Record) []string {
+	fieldNames := []string{}
+	for fieldName := range record.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	return fieldNames
+}
@@ -0,0 +1,72 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// maxIdentifierAttempts limits how often the values of a colliding identifier are regenerated
+const maxIdentifierAttempts = 100
+
+// claimIdentifiers regenerates the values of colliding identifiers until every identifier of the record is unique, then marks them as used
+func (g *Generator) claimIdentifiers(definitionName string, fields map[string]fieldSpec, identifiers map[string]yaml.ModelIdentifier, fieldValues map[string]any, sequence int64) error {
+	usedKeys := g.identifierKeys[definitionName]
+	if usedKeys == nil {
+		usedKeys = map[string]map[string]bool{}
+		g.identifierKeys[definitionName] = usedKeys
+	}
+
+	identifierNames := core.MapKeysSorted(identifiers)
+	for attempt := 0; ; attempt++ {
+		collidingName := ""
+		for _, identifierName := range identifierNames {
+			if usedKeys[identifierName][getIdentifierKey(fieldValues, identifiers[identifierName].Fields)] {
+				collidingName = identifierName
+				break
+			}
+		}
+		if collidingName == "" {
+			break
+		}
+		if attempt == maxIdentifierAttempts {
+			return ErrIdentifierValuesExhausted(definitionName, collidingName)
+		}
+
+		for _, fieldName := range identifiers[collidingName].Fields {
+			value, valueErr := g.generateValue(definitionName, fieldName, fields[fieldName].Type, true, sequence)
+			if valueErr != nil {
+				return valueErr
+			}
+			fieldValues[fieldName] = value
+		}
+	}
+
+	for _, identifierName := range identifierNames {
+		if usedKeys[identifierName] == nil {
+			usedKeys[identifierName] = map[string]bool{}
+		}
+		usedKeys[identifierName][getIdentifierKey(fieldValues, identifiers[identifierName].Fields)] = true
+	}
+	return nil
+}
+
+// getAllIdentifierFields returns the fields of all identifiers, sorted by name
+func getAllIdentifierFields(identifiers map[string]yaml.ModelIdentifier) []string {
+	identifierFields := map[string]bool{}
+	for _, identifier := range identifiers {
+		for _, fieldName := range identifier.Fields {
+			identifierFields[fieldName] = true
+		}
+	}
+	return core.MapKeysSorted(identifierFields)
+}
+
+func getIdentifierKey(fieldValues map[string]any, identifierFields []string) string {
+	values := make([]any, len(identifierFields))
+	for i, fieldName := range identifierFields {
+		values[i] = fieldValues[fieldName]
+	}
+	return fmt.Sprintf("%#v", values)
+}
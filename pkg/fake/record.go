@@ -0,0 +1,28 @@
+package fake
+
+import "reflect"
+
+// Record is a generated instance of a model, entity or structure
+type Record struct {
+	Definition string
+
+	// ID is the value of the primary identifier field, or a slice of values for composite identifiers
+	ID     any
+	Fields map[string]any
+
+	// Related holds the IDs of the related records for each model relation
+	Related map[string][]any
+}
+
+// Dataset holds generated model records by model name, in generation order
+type Dataset map[string][]Record
+
+// GetRecordByID returns the record of a model with the specified ID
+func (d Dataset) GetRecordByID(modelName string, id any) (Record, bool) {
+	for _, record := range d[modelName] {
+		if reflect.DeepEqual(record.ID, id) {
+			return record, true
+		}
+	}
+	return Record{}, false
+}
@@ -0,0 +1,130 @@
+package fake
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// baseTime anchors generated Time and Date values, so they do not depend on the current time
+var baseTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var firstNames = []string{"Ada", "Alan", "Grace", "Linus", "Margaret", "Dennis", "Barbara", "Ken", "Radia", "Edsger"}
+var lastNames = []string{"Lovelace", "Turing", "Hopper", "Torvalds", "Hamilton", "Ritchie", "Liskov", "Thompson", "Perlman", "Dijkstra"}
+var cityNames = []string{"Amsterdam", "Berlin", "Lisbon", "Oslo", "Prague", "Tokyo", "Toronto", "Vienna"}
+var streetNames = []string{"Main", "Oak", "Station", "Church", "Park", "Mill", "Harbour", "Garden"}
+var loremWords = []string{"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "tempor"}
+
+const secretCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// fieldSpec is the type and attributes of a field, regardless of the definition kind it belongs to
+type fieldSpec struct {
+	Type       string
+	Attributes []string
+}
+
+func (g *Generator) generateValue(definitionName string, fieldName string, typeName string, isIdentifier bool, sequence int64) (any, error) {
+	if enum, isEnum := g.enums[typeName]; isEnum {
+		return g.generateEnumValue(enum), nil
+	}
+	if _, isStructure := g.structures[typeName]; isStructure {
+		return g.generateStructureFields(typeName, sequence)
+	}
+
+	switch yaml.ModelFieldType(typeName) {
+	case yaml.ModelFieldTypeAutoIncrement:
+		return sequence, nil
+	case yaml.ModelFieldTypeUUID:
+		return g.generateUUID(), nil
+	case yaml.ModelFieldTypeString:
+		return g.generateString(fieldName, isIdentifier, sequence), nil
+	case yaml.ModelFieldTypeInteger:
+		if isIdentifier {
+			return sequence, nil
+		}
+		return int64(g.random.Intn(1000)), nil
+	case yaml.ModelFieldTypeFloat:
+		return math.Round(g.random.Float64()*100000) / 100, nil
+	case yaml.ModelFieldTypeBoolean:
+		return g.random.Intn(2) == 1, nil
+	case yaml.ModelFieldTypeTime:
+		return g.generateTime().Format(time.RFC3339), nil
+	case yaml.ModelFieldTypeDate:
+		return g.generateTime().Format(registry.DateFormat), nil
+	case yaml.ModelFieldTypeProtected:
+		return g.generateSecret(16), nil
+	case yaml.ModelFieldTypeSealed:
+		return g.generateSecret(24), nil
+	}
+	return nil, ErrUnknownFieldType(definitionName, fieldName, typeName)
+}
+
+func (g *Generator) generateEnumValue(enum yaml.Enum) any {
+	entryNames := enum.GetEntryNames()
+	return enum.Entries[entryNames[g.random.Intn(len(entryNames))]]
+}
+
+func (g *Generator) generateUUID() string {
+	uuid := make([]byte, 16)
+	g.random.Read(uuid)
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+}
+
+// generateString picks a realistic value based on the field name, identifier values are suffixed with the sequence to keep them unique
+func (g *Generator) generateString(fieldName string, isIdentifier bool, sequence int64) string {
+	lowerName := strings.ToLower(fieldName)
+	firstName := g.pick(firstNames)
+	lastName := g.pick(lastNames)
+
+	var value string
+	switch {
+	case strings.Contains(lowerName, "email"):
+		return fmt.Sprintf("%s.%s%d@example.com", strings.ToLower(firstName), strings.ToLower(lastName), sequence)
+	case strings.Contains(lowerName, "firstname"):
+		value = firstName
+	case strings.Contains(lowerName, "lastname"):
+		value = lastName
+	case strings.Contains(lowerName, "name"):
+		value = firstName + " " + lastName
+	case strings.Contains(lowerName, "city"):
+		value = g.pick(cityNames)
+	case strings.Contains(lowerName, "street"):
+		value = fmt.Sprintf("%d %s Street", g.random.Intn(200)+1, g.pick(streetNames))
+	case strings.Contains(lowerName, "phone"):
+		value = fmt.Sprintf("+1-555-%04d", g.random.Intn(10000))
+	default:
+		wordCount := g.random.Intn(3) + 2
+		words := make([]string, wordCount)
+		for i := range words {
+			words[i] = g.pick(loremWords)
+		}
+		value = strings.Join(words, " ")
+	}
+
+	if isIdentifier {
+		return fmt.Sprintf("%s %d", value, sequence)
+	}
+	return value
+}
+
+func (g *Generator) generateTime() time.Time {
+	return baseTime.Add(time.Duration(g.random.Int63n(int64(365*24*time.Hour/time.Second))) * time.Second)
+}
+
+func (g *Generator) generateSecret(length int) string {
+	secret := make([]byte, length)
+	for i := range secret {
+		secret[i] = secretCharacters[g.random.Intn(len(secretCharacters))]
+	}
+	return string(secret)
+}
+
+func (g *Generator) pick(values []string) string {
+	return values[g.random.Intn(len(values))]
+}
@@ -0,0 +1,149 @@
+package lockfile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// HashPrefix identifies the algorithm of a content hash
+const HashPrefix = "sha256:"
+
+// HashEnum returns the content hash of the normalized enum, entry order only counts for ordered enums
+func HashEnum(enum yaml.Enum) (string, error) {
+	normalized := enum.DeepClone()
+	yaml.NormalizeEnum(&normalized)
+	if normalized.Kind != yaml.EnumKindOrdered {
+		normalized.EntryOrder = nil
+	}
+	return hashContent(normalized)
+}
+
+// HashModel returns the content hash of the normalized model
+func HashModel(model yaml.Model) (string, error) {
+	normalized := model.DeepClone()
+	yaml.NormalizeModel(&normalized)
+	for fieldName, field := range normalized.Fields {
+		field.Attributes = getCanonicalAttributes(field.Attributes)
+		normalized.Fields[fieldName] = field
+	}
+	return hashContent(normalized)
+}
+
+// HashStructure returns the content hash of the normalized structure
+func HashStructure(structure yaml.Structure) (string, error) {
+	normalized := structure.DeepClone()
+	yaml.NormalizeStructure(&normalized)
+	for fieldName, field := range normalized.Fields {
+		field.Attributes = getCanonicalAttributes(field.Attributes)
+		normalized.Fields[fieldName] = field
+	}
+	return hashContent(normalized)
+}
+
+// HashEntity returns the content hash of the normalized entity
+func HashEntity(entity yaml.Entity) (string, error) {
+	normalized := entity.DeepClone()
+	yaml.NormalizeEntity(&normalized)
+	for fieldName, field := range normalized.Fields {
+		field.Attributes = getCanonicalAttributes(field.Attributes)
+		normalized.Fields[fieldName] = field
+	}
+	return hashContent(normalized)
+}
+
+// getCanonicalAttributes sorts field attributes, their declaration order carries no meaning
+func getCanonicalAttributes(attributes []string) []string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(attributes)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// hashContent hashes the canonical JSON encoding of a definition, which orders all map keys.
+// Callers drop order-only content such as plain enum entry order and attribute order first.
+func hashContent(definition any) (string, error) {
+	content, marshalErr := json.Marshal(definition)
+	if marshalErr != nil {
+		return "", marshalErr
+	}
+	return hashBytes(content), nil
+}
+
+func hashBytes(content []byte) string {
+	sum := sha256.Sum256(content)
+	return HashPrefix + hex.EncodeToString(sum[:])
+}
+
+// DefinitionHashes holds the content hashes of all registry definitions by kind and name
+type DefinitionHashes struct {
+	Enums      map[string]string `yaml:"enums,omitempty"`
+	Models     map[string]string `yaml:"models,omitempty"`
+	Structures map[string]string `yaml:"structures,omitempty"`
+	Entities   map[string]string `yaml:"entities,omitempty"`
+}
+
+// HashRegistry returns the content hashes of all definitions in the registry
+func HashRegistry(r *registry.Registry) (DefinitionHashes, error) {
+	enumHashes, enumsErr := hashAll(r.GetAllEnums(), HashEnum)
+	if enumsErr != nil {
+		return DefinitionHashes{}, enumsErr
+	}
+	modelHashes, modelsErr := hashAll(r.GetAllModels(), HashModel)
+	if modelsErr != nil {
+		return DefinitionHashes{}, modelsErr
+	}
+	structureHashes, structuresErr := hashAll(r.GetAllStructures(), HashStructure)
+	if structuresErr != nil {
+		return DefinitionHashes{}, structuresErr
+	}
+	entityHashes, entitiesErr := hashAll(r.GetAllEntities(), HashEntity)
+	if entitiesErr != nil {
+		return DefinitionHashes{}, entitiesErr
+	}
+
+	return DefinitionHashes{
+		Enums:      enumHashes,
+		Models:     modelHashes,
+		Structures: structureHashes,
+		Entities:   entityHashes,
+	}, nil
+}
+
+func hashAll[TDefinition any](definitions map[string]TDefinition, hash func(TDefinition) (string, error)) (map[string]string, error) {
+	hashes := make(map[string]string, len(definitions))
+	for name, definition := range definitions {
+		definitionHash, hashErr := hash(definition)
+		if hashErr != nil {
+			return nil, ErrDefinitionHash(name, hashErr)
+		}
+		hashes[strings.TrimSpace(name)] = definitionHash
+	}
+	return hashes, nil
+}
+
+// Fingerprint returns a single hash over all definition hashes, in kind and name order
+func (h DefinitionHashes) Fingerprint() string {
+	var content strings.Builder
+	writeKindHashes(&content, registrydiff.DefinitionKindEnum, h.Enums)
+	writeKindHashes(&content, registrydiff.DefinitionKindModel, h.Models)
+	writeKindHashes(&content, registrydiff.DefinitionKindStructure, h.Structures)
+	writeKindHashes(&content, registrydiff.DefinitionKindEntity, h.Entities)
+	return hashBytes([]byte(content.String()))
+}
+
+func writeKindHashes(content *strings.Builder, kind registrydiff.DefinitionKind, hashes map[string]string) {
+	for _, name := range core.MapKeysSorted(hashes) {
+		fmt.Fprintf(content, "%s %s %s\n", kind, name, hashes[name])
+	}
+}
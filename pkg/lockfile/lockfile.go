@@ -0,0 +1,120 @@
+package lockfile
+
+import (
+	"os"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// FileName is the conventional name of a morphe lockfile
+const FileName = "morphe.lock"
+
+// CurrentVersion is the lockfile format version written by this package
+const CurrentVersion = 1
+
+// Lockfile records the content hashes of a registry, so generators can skip unchanged definitions
+// and CI can detect generated code that is stale compared to its morphe sources
+type Lockfile struct {
+	Version     int              `yaml:"version"`
+	Fingerprint string           `yaml:"fingerprint"`
+	Definitions DefinitionHashes `yaml:"definitions"`
+}
+
+// StaleDefinition is a definition whose content hash differs between two lockfiles
+type StaleDefinition struct {
+	Kind   registrydiff.DefinitionKind
+	Name   string
+	Change registrydiff.ChangeType
+}
+
+// FromRegistry creates a lockfile from the current content of the registry
+func FromRegistry(r *registry.Registry) (Lockfile, error) {
+	if r == nil {
+		r = registry.NewRegistry()
+	}
+
+	hashes, hashErr := HashRegistry(r)
+	if hashErr != nil {
+		return Lockfile{}, hashErr
+	}
+	return Lockfile{
+		Version:     CurrentVersion,
+		Fingerprint: hashes.Fingerprint(),
+		Definitions: hashes,
+	}, nil
+}
+
+// Read loads a lockfile from the specified path
+func Read(filePath string) (Lockfile, error) {
+	fileContents, readErr := os.ReadFile(filePath)
+	if readErr != nil {
+		return Lockfile{}, ErrReadLockfile(filePath, readErr)
+	}
+
+	var lockfile Lockfile
+	unmarshalErr := yaml3.Unmarshal(fileContents, &lockfile)
+	if unmarshalErr != nil {
+		return Lockfile{}, ErrReadLockfile(filePath, unmarshalErr)
+	}
+	if lockfile.Version != CurrentVersion {
+		return Lockfile{}, ErrUnsupportedLockfileVersion(filePath, lockfile.Version)
+	}
+	return lockfile, nil
+}
+
+// Write stores the lockfile at the specified path
+func (l Lockfile) Write(filePath string) error {
+	fileContents, marshalErr := yaml3.Marshal(l)
+	if marshalErr != nil {
+		return ErrWriteLockfile(filePath, marshalErr)
+	}
+
+	writeErr := os.WriteFile(filePath, fileContents, 0644)
+	if writeErr != nil {
+		return ErrWriteLockfile(filePath, writeErr)
+	}
+	return nil
+}
+
+// IsUpToDate returns true if the current lockfile has the same fingerprint
+func (l Lockfile) IsUpToDate(current Lockfile) bool {
+	return l.Fingerprint == current.Fingerprint
+}
+
+// GetStale returns all definitions that were added, removed or changed in the current lockfile, in kind and name order
+func (l Lockfile) GetStale(current Lockfile) []StaleDefinition {
+	stale := []StaleDefinition{}
+	stale = append(stale, getStaleOfKind(registrydiff.DefinitionKindEnum, l.Definitions.Enums, current.Definitions.Enums)...)
+	stale = append(stale, getStaleOfKind(registrydiff.DefinitionKindModel, l.Definitions.Models, current.Definitions.Models)...)
+	stale = append(stale, getStaleOfKind(registrydiff.DefinitionKindStructure, l.Definitions.Structures, current.Definitions.Structures)...)
+	stale = append(stale, getStaleOfKind(registrydiff.DefinitionKindEntity, l.Definitions.Entities, current.Definitions.Entities)...)
+	return stale
+}
+
+func getStaleOfKind(kind registrydiff.DefinitionKind, locked map[string]string, current map[string]string) []StaleDefinition {
+	allNames := map[string]bool{}
+	for name := range locked {
+		allNames[name] = true
+	}
+	for name := range current {
+		allNames[name] = true
+	}
+
+	stale := []StaleDefinition{}
+	for _, name := range core.MapKeysSorted(allNames) {
+		lockedHash, isLocked := locked[name]
+		currentHash, isCurrent := current[name]
+		switch {
+		case !isLocked:
+			stale = append(stale, StaleDefinition{Kind: kind, Name: name, Change: registrydiff.ChangeTypeAdded})
+		case !isCurrent:
+			stale = append(stale, StaleDefinition{Kind: kind, Name: name, Change: registrydiff.ChangeTypeRemoved})
+		case lockedHash != currentHash:
+			stale = append(stale, StaleDefinition{Kind: kind, Name: name, Change: registrydiff.ChangeTypeChanged})
+		}
+	}
+	return stale
+}
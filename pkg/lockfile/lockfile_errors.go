@@ -0,0 +1,19 @@
+package lockfile
+
+import "fmt"
+
+func ErrDefinitionHash(name string, hashErr error) error {
+	return fmt.Errorf("error hashing definition '%s': %w", name, hashErr)
+}
+
+func ErrReadLockfile(filePath string, readErr error) error {
+	return fmt.Errorf("error reading lockfile '%s': %w", filePath, readErr)
+}
+
+func ErrWriteLockfile(filePath string, writeErr error) error {
+	return fmt.Errorf("error writing lockfile '%s': %w", filePath, writeErr)
+}
+
+func ErrUnsupportedLockfileVersion(filePath string, version int) error {
+	return fmt.Errorf("lockfile '%s' has unsupported version %d, expected %d", filePath, version, CurrentVersion)
+}
@@ -0,0 +1,184 @@
+package lockfile_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kalo-build/morphe-go/pkg/lockfile"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func getLockRegistry(personFieldType yaml.ModelFieldType) *registry.Registry {
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", yaml.Enum{
+		Name:    "Nationality",
+		Type:    yaml.EnumTypeString,
+		Entries: map[string]any{"US": "American", "DE": "German"},
+	})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":   {Type: yaml.ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+			"Name": {Type: personFieldType},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	})
+	return r
+}
+
+func TestHashModel_IgnoresWhitespace(t *testing.T) {
+	model := yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID": {Type: yaml.ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+		},
+	}
+	paddedModel := yaml.Model{
+		Name: " Person ",
+		Fields: map[string]yaml.ModelField{
+			" ID": {Type: " AutoIncrement", Attributes: []string{"mandatory "}},
+		},
+	}
+
+	modelHash, modelErr := lockfile.HashModel(model)
+	paddedHash, paddedErr := lockfile.HashModel(paddedModel)
+
+	require.NoError(t, modelErr)
+	require.NoError(t, paddedErr)
+	assert.Equal(t, modelHash, paddedHash)
+	assert.Contains(t, modelHash, lockfile.HashPrefix)
+}
+
+func TestHashEnum_IgnoresMapOrder(t *testing.T) {
+	entries := map[string]any{}
+	reversedEntries := map[string]any{}
+	names := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+	for idx, name := range names {
+		entries[name] = idx
+		reversedEntries[names[len(names)-1-idx]] = len(names) - 1 - idx
+	}
+
+	enumHash, enumErr := lockfile.HashEnum(yaml.Enum{Name: "Letter", Type: yaml.EnumTypeInteger, Entries: entries})
+	reversedHash, reversedErr := lockfile.HashEnum(yaml.Enum{Name: "Letter", Type: yaml.EnumTypeInteger, Entries: reversedEntries})
+
+	require.NoError(t, enumErr)
+	require.NoError(t, reversedErr)
+	assert.Equal(t, enumHash, reversedHash)
+}
+
+func TestHashEnum_IgnoresDeclarationOrder(t *testing.T) {
+	enum := yaml.Enum{Name: "Letter", Type: yaml.EnumTypeInteger, Entries: map[string]any{"A": 1, "B": 2}, EntryOrder: []string{"A", "B"}}
+	reordered := yaml.Enum{Name: "Letter", Type: yaml.EnumTypeInteger, Entries: map[string]any{"A": 1, "B": 2}, EntryOrder: []string{"B", "A"}}
+	ordered := enum
+	ordered.Kind = yaml.EnumKindOrdered
+	reorderedOrdered := reordered
+	reorderedOrdered.Kind = yaml.EnumKindOrdered
+
+	enumHash, enumErr := lockfile.HashEnum(enum)
+	reorderedHash, reorderedErr := lockfile.HashEnum(reordered)
+	orderedHash, orderedErr := lockfile.HashEnum(ordered)
+	reorderedOrderedHash, reorderedOrderedErr := lockfile.HashEnum(reorderedOrdered)
+
+	require.NoError(t, enumErr)
+	require.NoError(t, reorderedErr)
+	require.NoError(t, orderedErr)
+	require.NoError(t, reorderedOrderedErr)
+	assert.Equal(t, enumHash, reorderedHash)
+	assert.NotEqual(t, orderedHash, reorderedOrderedHash)
+}
+
+func TestHashModel_IgnoresAttributeOrder(t *testing.T) {
+	model := yaml.Model{Name: "Person", Fields: map[string]yaml.ModelField{
+		"UUID": {Type: yaml.ModelFieldTypeUUID, Attributes: []string{"immutable", "mandatory"}},
+	}}
+	reordered := yaml.Model{Name: "Person", Fields: map[string]yaml.ModelField{
+		"UUID": {Type: yaml.ModelFieldTypeUUID, Attributes: []string{"mandatory", "immutable"}},
+	}}
+
+	modelHash, modelErr := lockfile.HashModel(model)
+	reorderedHash, reorderedErr := lockfile.HashModel(reordered)
+
+	require.NoError(t, modelErr)
+	require.NoError(t, reorderedErr)
+	assert.Equal(t, modelHash, reorderedHash)
+	assert.Equal(t, []string{"mandatory", "immutable"}, reordered.Fields["UUID"].Attributes)
+}
+
+func TestHashModel_ContentChange(t *testing.T) {
+	model := yaml.Model{Name: "Person", Fields: map[string]yaml.ModelField{"Age": {Type: yaml.ModelFieldTypeInteger}}}
+	changedModel := yaml.Model{Name: "Person", Fields: map[string]yaml.ModelField{"Age": {Type: yaml.ModelFieldTypeFloat}}}
+
+	modelHash, modelErr := lockfile.HashModel(model)
+	changedHash, changedErr := lockfile.HashModel(changedModel)
+
+	require.NoError(t, modelErr)
+	require.NoError(t, changedErr)
+	assert.NotEqual(t, modelHash, changedHash)
+}
+
+func TestFromRegistry(t *testing.T) {
+	lock, lockErr := lockfile.FromRegistry(getLockRegistry(yaml.ModelFieldTypeString))
+	sameLock, sameLockErr := lockfile.FromRegistry(getLockRegistry(yaml.ModelFieldTypeString))
+
+	require.NoError(t, lockErr)
+	require.NoError(t, sameLockErr)
+	assert.Equal(t, lockfile.CurrentVersion, lock.Version)
+	assert.Len(t, lock.Definitions.Enums, 1)
+	assert.Len(t, lock.Definitions.Models, 1)
+	assert.Empty(t, lock.Definitions.Structures)
+	assert.Empty(t, lock.Definitions.Entities)
+	assert.Equal(t, lock, sameLock)
+	assert.True(t, lock.IsUpToDate(sameLock))
+	assert.Empty(t, lock.GetStale(sameLock))
+}
+
+func TestLockfile_GetStale(t *testing.T) {
+	lock, lockErr := lockfile.FromRegistry(getLockRegistry(yaml.ModelFieldTypeString))
+	require.NoError(t, lockErr)
+
+	current := getLockRegistry(yaml.ModelFieldTypeInteger)
+	current.SetStructure("Address", yaml.Structure{
+		Name:   "Address",
+		Fields: map[string]yaml.StructureField{"Street": {Type: yaml.StructureFieldTypeString}},
+	})
+	currentLock, currentLockErr := lockfile.FromRegistry(current)
+	require.NoError(t, currentLockErr)
+
+	assert.False(t, lock.IsUpToDate(currentLock))
+	assert.Equal(t, []lockfile.StaleDefinition{
+		{Kind: registrydiff.DefinitionKindModel, Name: "Person", Change: registrydiff.ChangeTypeChanged},
+		{Kind: registrydiff.DefinitionKindStructure, Name: "Address", Change: registrydiff.ChangeTypeAdded},
+	}, lock.GetStale(currentLock))
+	assert.Equal(t, []lockfile.StaleDefinition{
+		{Kind: registrydiff.DefinitionKindModel, Name: "Person", Change: registrydiff.ChangeTypeChanged},
+		{Kind: registrydiff.DefinitionKindStructure, Name: "Address", Change: registrydiff.ChangeTypeRemoved},
+	}, currentLock.GetStale(lock))
+}
+
+func TestLockfile_WriteRead(t *testing.T) {
+	lock, lockErr := lockfile.FromRegistry(getLockRegistry(yaml.ModelFieldTypeString))
+	require.NoError(t, lockErr)
+	lockPath := filepath.Join(t.TempDir(), lockfile.FileName)
+
+	writeErr := lock.Write(lockPath)
+	readLock, readErr := lockfile.Read(lockPath)
+
+	require.NoError(t, writeErr)
+	require.NoError(t, readErr)
+	assert.Equal(t, lock.Fingerprint, readLock.Fingerprint)
+	assert.Equal(t, lock.Definitions.Models, readLock.Definitions.Models)
+	assert.Empty(t, lock.GetStale(readLock))
+}
+
+func TestLockfile_ReadMissing(t *testing.T) {
+	_, readErr := lockfile.Read(filepath.Join(t.TempDir(), lockfile.FileName))
+
+	assert.ErrorContains(t, readErr, "error reading lockfile")
+}
@@ -0,0 +1,46 @@
+package memstore
+
+import (
+	"github.com/kalo-build/morphe-go/pkg/projection"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// storeDataSource exposes the store records to the entity projector
+type storeDataSource struct {
+	store *Store
+}
+
+// AsDataSource returns the store as a projection data source
+func (s *Store) AsDataSource() projection.DataSource {
+	return storeDataSource{store: s}
+}
+
+func (d storeDataSource) GetRecord(modelName string, id any) (map[string]any, error) {
+	d.store.mutex.RLock()
+	defer d.store.mutex.RUnlock()
+
+	model, modelErr := d.store.getModel(modelName)
+	if modelErr != nil {
+		return nil, modelErr
+	}
+	values, valuesErr := getIdentifierValues(modelName, "primary", model.Identifiers["primary"], id)
+	if valuesErr != nil {
+		return nil, valuesErr
+	}
+	record, exists := d.store.records[modelName][getIdentifierKey(values)]
+	if !exists {
+		return nil, nil
+	}
+	return cloneRecord(record), nil
+}
+
+func (d storeDataSource) GetRelated(hop yaml.ModelFieldPathHop, record map[string]any) ([]map[string]any, error) {
+	d.store.mutex.RLock()
+	defer d.store.mutex.RUnlock()
+
+	model, modelErr := d.store.getModel(hop.FromModel)
+	if modelErr != nil {
+		return nil, modelErr
+	}
+	return d.store.traverse(model, record, hop.RelationName, hop.Relation)
+}
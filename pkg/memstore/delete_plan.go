@@ -0,0 +1,120 @@
+package memstore
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+)
+
+// deletePlan collects the effects of a delete, so a restricted delete leaves the store untouched
+type deletePlan struct {
+	deletes      map[string]map[string]bool
+	deleteOrder  []recordRef
+	nullifyOrder []nullifyRef
+}
+
+type recordRef struct {
+	Model string
+	Key   string
+}
+
+type nullifyRef struct {
+	Record     recordRef
+	ForeignKey string
+}
+
+func newDeletePlan() *deletePlan {
+	return &deletePlan{
+		deletes: map[string]map[string]bool{},
+	}
+}
+
+func (p *deletePlan) isDeleted(modelName string, key string) bool {
+	return p.deletes[modelName][key]
+}
+
+func (p *deletePlan) addDelete(modelName string, key string) {
+	if p.deletes[modelName] == nil {
+		p.deletes[modelName] = map[string]bool{}
+	}
+	p.deletes[modelName][key] = true
+	p.deleteOrder = append(p.deleteOrder, recordRef{Model: modelName, Key: key})
+}
+
+// planDelete adds a record and the effects on the records referencing it to the plan, the caller must hold the write lock
+func (s *Store) planDelete(plan *deletePlan, modelName string, key string, id any) error {
+	if plan.isDeleted(modelName, key) {
+		return nil
+	}
+	plan.addDelete(modelName, key)
+
+	for _, referencingName := range core.MapKeysSorted(s.models) {
+		referencingModel := s.models[referencingName]
+		for _, relationName := range core.MapKeysSorted(referencingModel.Related) {
+			relation := referencingModel.Related[relationName]
+			if !s.isReferencingRelation(relation, relationName, modelName) {
+				continue
+			}
+			if referenceErr := s.planReferences(plan, referencingModel, relationName, relation, key, id); referenceErr != nil {
+				return referenceErr
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Store) isReferencingRelation(relation yaml.ModelRelation, relationName string, modelName string) bool {
+	return yamlops.IsRelationFor(relation.Type) && yamlops.IsRelationOne(relation.Type) && !yamlops.IsRelationPoly(relation.Type) &&
+		yamlops.GetRelationTargetName(relationName, relation.Aliased) == modelName
+}
+
+func (s *Store) planReferences(plan *deletePlan, referencingModel yaml.Model, relationName string, relation yaml.ModelRelation, key string, id any) error {
+	foreignKey := yamlops.GetForRelationForeignKey(relationName, relation)
+	for _, referencingKey := range s.keys[referencingModel.Name] {
+		referencing := s.records[referencingModel.Name][referencingKey]
+		if referencing[foreignKey] == nil || getIdentifierKey([]any{referencing[foreignKey]}) != key {
+			continue
+		}
+		if plan.isDeleted(referencingModel.Name, referencingKey) {
+			continue
+		}
+
+		switch relation.OnDelete {
+		case yaml.ModelRelationOnDeleteCascade:
+			referencingID := getRecordID(referencing, referencingModel.Identifiers["primary"].Fields)
+			if cascadeErr := s.planDelete(plan, referencingModel.Name, referencingKey, referencingID); cascadeErr != nil {
+				return cascadeErr
+			}
+		case yaml.ModelRelationOnDeleteSetNull:
+			plan.nullifyOrder = append(plan.nullifyOrder, nullifyRef{
+				Record:     recordRef{Model: referencingModel.Name, Key: referencingKey},
+				ForeignKey: foreignKey,
+			})
+		default:
+			return ErrRecordReferenced(yamlops.GetRelationTargetName(relationName, relation.Aliased), id, referencingModel.Name, relationName)
+		}
+	}
+	return nil
+}
+
+// applyDelete removes the planned records and unsets the foreign keys of the records that survive
+func (s *Store) applyDelete(plan *deletePlan) {
+	for _, nullify := range plan.nullifyOrder {
+		if plan.isDeleted(nullify.Record.Model, nullify.Record.Key) {
+			continue
+		}
+		delete(s.records[nullify.Record.Model][nullify.Record.Key], nullify.ForeignKey)
+	}
+	for _, ref := range plan.deleteOrder {
+		delete(s.records[ref.Model], ref.Key)
+	}
+	for modelName := range plan.deletes {
+		remainingKeys := []string{}
+		for _, key := range s.keys[modelName] {
+			if !plan.isDeleted(modelName, key) {
+				remainingKeys = append(remainingKeys, key)
+			}
+		}
+		s.keys[modelName] = remainingKeys
+	}
+}
@@ -0,0 +1,108 @@
+package memstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// getIdentifierKey builds a comparable key from identifier values, numbers of any Go type with the same value share a key
+func getIdentifierKey(values []any) string {
+	keyParts := make([]string, len(values))
+	for i, value := range values {
+		keyParts[i] = getIdentifierKeyPart(value)
+	}
+	return strings.Join(keyParts, "\x00")
+}
+
+func getIdentifierKeyPart(value any) string {
+	if integerValue, isInteger := toInteger(value); isInteger {
+		return "i:" + strconv.FormatInt(integerValue, 10)
+	}
+	switch typedValue := value.(type) {
+	case float32:
+		return "f:" + strconv.FormatFloat(float64(typedValue), 'g', -1, 64)
+	case float64:
+		return "f:" + strconv.FormatFloat(typedValue, 'g', -1, 64)
+	case string:
+		return "s:" + typedValue
+	}
+	return fmt.Sprintf("%T:%v", value, value)
+}
+
+// getIdentifierValues converts an identifier value into the values of its fields, composite identifiers take a slice
+func getIdentifierValues(modelName string, identifierName string, identifier yaml.ModelIdentifier, id any) ([]any, error) {
+	if len(identifier.Fields) == 1 {
+		return []any{id}, nil
+	}
+	values, isSlice := id.([]any)
+	if !isSlice || len(values) != len(identifier.Fields) {
+		return nil, ErrInvalidIdentifierValue(modelName, identifierName, id)
+	}
+	return values, nil
+}
+
+func getFieldValues(record map[string]any, fieldNames []string) []any {
+	values := make([]any, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		values[i] = record[fieldName]
+	}
+	return values
+}
+
+// getRecordID returns the primary identifier value of a record, a slice of values for composite identifiers
+func getRecordID(record map[string]any, primaryFields []string) any {
+	if len(primaryFields) == 1 {
+		return record[primaryFields[0]]
+	}
+	return getFieldValues(record, primaryFields)
+}
+
+func toInteger(value any) (int64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return int64(typedValue), true
+	case int32:
+		return int64(typedValue), true
+	case int64:
+		return typedValue, true
+	case uint:
+		return int64(typedValue), uint64(typedValue) <= math.MaxInt64
+	case uint32:
+		return int64(typedValue), true
+	case uint64:
+		return int64(typedValue), typedValue <= math.MaxInt64
+	case float64:
+		return int64(typedValue), typedValue == math.Trunc(typedValue) && typedValue >= math.MinInt64 && typedValue < math.MaxInt64
+	case json.Number:
+		integerValue, parseErr := typedValue.Int64()
+		return integerValue, parseErr == nil
+	}
+	return 0, false
+}
+
+func cloneRecord(record map[string]any) map[string]any {
+	recordClone := make(map[string]any, len(record))
+	for fieldName, value := range record {
+		recordClone[fieldName] = cloneValue(value)
+	}
+	return recordClone
+}
+
+func cloneValue(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		return cloneRecord(typedValue)
+	case []any:
+		valuesClone := make([]any, len(typedValue))
+		for i, item := range typedValue {
+			valuesClone[i] = cloneValue(item)
+		}
+		return valuesClone
+	}
+	return value
+}
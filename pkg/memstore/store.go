@@ -0,0 +1,344 @@
+package memstore
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+)
+
+// Store is an in-memory record store that enforces the constraints of the registry models.
+// For relations are stored as foreign key values, '<RelationName>ID' unless the relation declares a foreignKey.
+type Store struct {
+	mutex sync.RWMutex
+
+	registry *registry.Registry
+	models   map[string]yaml.Model
+
+	// records holds the records of each model by primary identifier key, keys lists them in insertion order
+	records map[string]map[string]map[string]any
+	keys    map[string][]string
+
+	// sequences holds the last AutoIncrement value of each model field
+	sequences map[string]map[string]int64
+}
+
+// NewStore creates an empty store for a snapshot of the registry models
+func NewStore(r *registry.Registry) *Store {
+	return &Store{
+		registry:  r.DeepClone(),
+		models:    r.GetAllModels(),
+		records:   map[string]map[string]map[string]any{},
+		keys:      map[string][]string{},
+		sequences: map[string]map[string]int64{},
+	}
+}
+
+// Insert validates and stores a new record, generating missing AutoIncrement values, and returns the stored record
+func (s *Store) Insert(modelName string, record map[string]any) (map[string]any, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	model, modelErr := s.getModel(modelName)
+	if modelErr != nil {
+		return nil, modelErr
+	}
+
+	stored := cloneRecord(record)
+	s.setAutoIncrementValues(model, stored)
+	if writeErr := s.checkWrite(model, stored, ""); writeErr != nil {
+		return nil, writeErr
+	}
+
+	key := getIdentifierKey(getFieldValues(stored, model.Identifiers["primary"].Fields))
+	if s.records[modelName] == nil {
+		s.records[modelName] = map[string]map[string]any{}
+	}
+	s.records[modelName][key] = stored
+	s.keys[modelName] = append(s.keys[modelName], key)
+	return cloneRecord(stored), nil
+}
+
+// Get returns the record with the specified primary identifier value, a slice of values for composite identifiers
+func (s *Store) Get(modelName string, id any) (map[string]any, error) {
+	return s.GetByIdentifier(modelName, "primary", id)
+}
+
+// GetByIdentifier returns the record with the specified value of any declared identifier
+func (s *Store) GetByIdentifier(modelName string, identifierName string, id any) (map[string]any, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	model, modelErr := s.getModel(modelName)
+	if modelErr != nil {
+		return nil, modelErr
+	}
+	identifier, identifierExists := model.Identifiers[identifierName]
+	if !identifierExists {
+		return nil, ErrUnknownIdentifier(modelName, identifierName)
+	}
+	values, valuesErr := getIdentifierValues(modelName, identifierName, identifier, id)
+	if valuesErr != nil {
+		return nil, valuesErr
+	}
+
+	key := getIdentifierKey(values)
+	for _, recordKey := range s.keys[modelName] {
+		record := s.records[modelName][recordKey]
+		if getIdentifierKey(getFieldValues(record, identifier.Fields)) == key {
+			return cloneRecord(record), nil
+		}
+	}
+	return nil, ErrRecordNotFound(modelName, id)
+}
+
+// List returns all records of a model in insertion order
+func (s *Store) List(modelName string) ([]map[string]any, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, modelErr := s.getModel(modelName); modelErr != nil {
+		return nil, modelErr
+	}
+	records := make([]map[string]any, 0, len(s.keys[modelName]))
+	for _, key := range s.keys[modelName] {
+		records = append(records, cloneRecord(s.records[modelName][key]))
+	}
+	return records, nil
+}
+
+// Update applies changes to a stored record and returns the updated record, nil values unset fields
+func (s *Store) Update(modelName string, id any, changes map[string]any) (map[string]any, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	model, key, existing, findErr := s.findRecord(modelName, id)
+	if findErr != nil {
+		return nil, findErr
+	}
+
+	updated := cloneRecord(existing)
+	for fieldName, value := range changes {
+		if value == nil {
+			delete(updated, fieldName)
+			continue
+		}
+		updated[fieldName] = cloneValue(value)
+	}
+	// Immutable values are compared like identifier values, so an unchanged number of another Go type is accepted and the stored value is kept
+	for _, fieldName := range core.MapKeysSorted(model.Fields) {
+		isImmutable := slices.Contains(model.Fields[fieldName].Attributes, "immutable") || slices.Contains(model.Identifiers["primary"].Fields, fieldName)
+		if !isImmutable {
+			continue
+		}
+		if getIdentifierKey([]any{existing[fieldName]}) != getIdentifierKey([]any{updated[fieldName]}) {
+			return nil, ErrImmutableField(modelName, fieldName)
+		}
+		if value, exists := existing[fieldName]; exists {
+			updated[fieldName] = value
+		}
+	}
+	if writeErr := s.checkWrite(model, updated, key); writeErr != nil {
+		return nil, writeErr
+	}
+
+	s.records[modelName][key] = updated
+	return cloneRecord(updated), nil
+}
+
+// Delete removes a record and applies the onDelete action of the For relations that reference it.
+// Relations without an action restrict the delete, like 'restrict'.
+func (s *Store) Delete(modelName string, id any) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, key, _, findErr := s.findRecord(modelName, id)
+	if findErr != nil {
+		return findErr
+	}
+
+	plan := newDeletePlan()
+	if planErr := s.planDelete(plan, modelName, key, id); planErr != nil {
+		return planErr
+	}
+	s.applyDelete(plan)
+	return nil
+}
+
+// Traverse returns the records related to a record through a model relation
+func (s *Store) Traverse(modelName string, id any, relationName string) ([]map[string]any, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	model, _, record, findErr := s.findRecord(modelName, id)
+	if findErr != nil {
+		return nil, findErr
+	}
+	relation, relationExists := model.Related[relationName]
+	if !relationExists {
+		return nil, ErrUnknownRelation(modelName, relationName)
+	}
+	return s.traverse(model, record, relationName, relation)
+}
+
+func (s *Store) traverse(model yaml.Model, record map[string]any, relationName string, relation yaml.ModelRelation) ([]map[string]any, error) {
+	if yamlops.IsRelationPoly(relation.Type) {
+		return nil, ErrUnsupportedPolyRelation(model.Name, relationName)
+	}
+	targetName := yamlops.GetRelationTargetName(relationName, relation.Aliased)
+	targetModel, targetErr := s.getModel(targetName)
+	if targetErr != nil {
+		return nil, targetErr
+	}
+
+	related := []map[string]any{}
+	if yamlops.IsRelationFor(relation.Type) {
+		foreignKeyValue := record[yamlops.GetForRelationForeignKey(relationName, relation)]
+		if foreignKeyValue == nil {
+			return related, nil
+		}
+		target, exists := s.records[targetName][getIdentifierKey([]any{foreignKeyValue})]
+		if exists {
+			related = append(related, cloneRecord(target))
+		}
+		return related, nil
+	}
+
+	foreignKey, foreignKeyErr := yamlops.GetHasRelationForeignKey(model.Name, relation, targetModel)
+	if foreignKeyErr != nil {
+		return nil, foreignKeyErr
+	}
+	recordKey := getIdentifierKey(getFieldValues(record, model.Identifiers["primary"].Fields))
+	for _, targetKey := range s.keys[targetName] {
+		target := s.records[targetName][targetKey]
+		if target[foreignKey] != nil && getIdentifierKey([]any{target[foreignKey]}) == recordKey {
+			related = append(related, cloneRecord(target))
+		}
+	}
+	return related, nil
+}
+
+func (s *Store) getModel(modelName string) (yaml.Model, error) {
+	model, exists := s.models[modelName]
+	if !exists {
+		return yaml.Model{}, ErrUnknownModel(modelName)
+	}
+	if len(model.Identifiers["primary"].Fields) == 0 {
+		return yaml.Model{}, ErrNoPrimaryIdentifier(modelName)
+	}
+	return model, nil
+}
+
+// findRecord returns the model, key and stored record for a primary identifier value, the caller must hold the lock
+func (s *Store) findRecord(modelName string, id any) (yaml.Model, string, map[string]any, error) {
+	model, modelErr := s.getModel(modelName)
+	if modelErr != nil {
+		return yaml.Model{}, "", nil, modelErr
+	}
+	values, valuesErr := getIdentifierValues(modelName, "primary", model.Identifiers["primary"], id)
+	if valuesErr != nil {
+		return yaml.Model{}, "", nil, valuesErr
+	}
+	key := getIdentifierKey(values)
+	record, exists := s.records[modelName][key]
+	if !exists {
+		return yaml.Model{}, "", nil, ErrRecordNotFound(modelName, id)
+	}
+	return model, key, record, nil
+}
+
+// setAutoIncrementValues fills missing AutoIncrement fields and keeps the sequences ahead of explicit values
+func (s *Store) setAutoIncrementValues(model yaml.Model, record map[string]any) {
+	if s.sequences[model.Name] == nil {
+		s.sequences[model.Name] = map[string]int64{}
+	}
+	sequences := s.sequences[model.Name]
+	for _, fieldName := range core.MapKeysSorted(model.Fields) {
+		if model.Fields[fieldName].Type != yaml.ModelFieldTypeAutoIncrement {
+			continue
+		}
+		if value, isInteger := toInteger(record[fieldName]); isInteger {
+			sequences[fieldName] = max(sequences[fieldName], value)
+			continue
+		}
+		if record[fieldName] == nil {
+			sequences[fieldName]++
+			record[fieldName] = sequences[fieldName]
+		}
+	}
+}
+
+// checkWrite validates a record, its identifier uniqueness and For relations, ignoring the record stored under ownKey
+func (s *Store) checkWrite(model yaml.Model, record map[string]any, ownKey string) error {
+	fields := map[string]any{}
+	for fieldName, value := range record {
+		if !s.isForeignKeyOnly(model, fieldName) {
+			fields[fieldName] = value
+		}
+	}
+	if validationErr := s.registry.ValidateModelRecord(model.Name, fields); validationErr != nil {
+		return validationErr
+	}
+	if uniqueErr := s.checkIdentifiersUnique(model, record, ownKey); uniqueErr != nil {
+		return uniqueErr
+	}
+	return s.checkForRelations(model, record)
+}
+
+// isForeignKeyOnly checks whether a record key is a For relation foreign key that is not declared as a model field
+func (s *Store) isForeignKeyOnly(model yaml.Model, key string) bool {
+	if _, isField := model.Fields[key]; isField {
+		return false
+	}
+	for relationName, relation := range model.Related {
+		if yamlops.IsRelationFor(relation.Type) && !yamlops.IsRelationPoly(relation.Type) && yamlops.GetForRelationForeignKey(relationName, relation) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) checkIdentifiersUnique(model yaml.Model, record map[string]any, ownKey string) error {
+	for _, identifierName := range core.MapKeysSorted(model.Identifiers) {
+		fieldNames := model.Identifiers[identifierName].Fields
+		values := getFieldValues(record, fieldNames)
+		if slices.Contains(values, nil) {
+			continue
+		}
+		key := getIdentifierKey(values)
+		for otherKey, other := range s.records[model.Name] {
+			if otherKey != ownKey && getIdentifierKey(getFieldValues(other, fieldNames)) == key {
+				return ErrDuplicateIdentifier(model.Name, identifierName, values)
+			}
+		}
+	}
+	return nil
+}
+
+// checkForRelations enforces the referential integrity of the ForOne relations of a record
+func (s *Store) checkForRelations(model yaml.Model, record map[string]any) error {
+	for _, relationName := range core.MapKeysSorted(model.Related) {
+		relation := model.Related[relationName]
+		if !yamlops.IsRelationFor(relation.Type) || !yamlops.IsRelationOne(relation.Type) || yamlops.IsRelationPoly(relation.Type) {
+			continue
+		}
+
+		foreignKey := yamlops.GetForRelationForeignKey(relationName, relation)
+		foreignKeyValue := record[foreignKey]
+		if foreignKeyValue == nil {
+			if relation.Required {
+				return ErrMissingRelation(model.Name, relationName, foreignKey)
+			}
+			continue
+		}
+
+		targetName := yamlops.GetRelationTargetName(relationName, relation.Aliased)
+		if _, exists := s.records[targetName][getIdentifierKey([]any{foreignKeyValue})]; !exists {
+			return ErrUnknownRelationTarget(model.Name, relationName, targetName, foreignKeyValue)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package memstore
+
+import "fmt"
+
+func ErrUnknownModel(modelName string) error {
+	return fmt.Errorf("model with name '%s' not found in registry", modelName)
+}
+
+func ErrNoPrimaryIdentifier(modelName string) error {
+	return fmt.Errorf("model '%s' has no primary identifier", modelName)
+}
+
+func ErrUnknownIdentifier(modelName string, identifierName string) error {
+	return fmt.Errorf("model '%s' has no identifier '%s'", modelName, identifierName)
+}
+
+func ErrInvalidIdentifierValue(modelName string, identifierName string, id any) error {
+	return fmt.Errorf("value '%v' does not match model '%s' identifier '%s'", id, modelName, identifierName)
+}
+
+func ErrRecordNotFound(modelName string, id any) error {
+	return fmt.Errorf("model '%s' record with identifier '%v' not found", modelName, id)
+}
+
+func ErrDuplicateIdentifier(modelName string, identifierName string, values []any) error {
+	return fmt.Errorf("model '%s' already has a record with identifier '%s' value %v", modelName, identifierName, values)
+}
+
+func ErrImmutableField(modelName string, fieldName string) error {
+	return fmt.Errorf("model '%s' field '%s' is immutable", modelName, fieldName)
+}
+
+func ErrMissingRelation(modelName string, relationName string, foreignKey string) error {
+	return fmt.Errorf("model '%s' relation '%s' is required, but '%s' is not set", modelName, relationName, foreignKey)
+}
+
+func ErrUnknownRelationTarget(modelName string, relationName string, targetName string, id any) error {
+	return fmt.Errorf("model '%s' relation '%s' references missing '%s' record with identifier '%v'", modelName, relationName, targetName, id)
+}
+
+func ErrRecordReferenced(modelName string, id any, referencingModelName string, relationName string) error {
+	return fmt.Errorf("model '%s' record with identifier '%v' is referenced by '%s' relation '%s'", modelName, id, referencingModelName, relationName)
+}
+
+func ErrUnknownRelation(modelName string, relationName string) error {
+	return fmt.Errorf("model '%s' has no relation '%s'", modelName, relationName)
+}
+
+func ErrUnsupportedPolyRelation(modelName string, relationName string) error {
+	return fmt.Errorf("model '%s' relation '%s' is polymorphic, which is not supported", modelName, relationName)
+}
@@ -0,0 +1,193 @@
+package memstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/memstore"
+	"github.com/kalo-build/morphe-go/pkg/projection"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	suite.Registry = r
+}
+
+func (suite *StoreTestSuite) TearDownTest() {
+	suite.Registry = nil
+}
+
+func (suite *StoreTestSuite) getPopulatedStore() *memstore.Store {
+	store := memstore.NewStore(suite.Registry)
+	_, companyErr := store.Insert("Company", map[string]any{"Name": "Analytical Engines"})
+	suite.Require().NoError(companyErr)
+	_, personErr := store.Insert("Person", map[string]any{"UUID": "3f2504e0-4f89-11d3-9a0c-0305e82c3301", "FirstName": "Ada", "LastName": "Lovelace", "EmployerID": 1})
+	suite.Require().NoError(personErr)
+	_, contactErr := store.Insert("ContactInfo", map[string]any{"Email": "ada@example.com", "PersonID": 1})
+	suite.Require().NoError(contactErr)
+	return store
+}
+
+func (suite *StoreTestSuite) TestInsert_GeneratesAutoIncrement() {
+	store := memstore.NewStore(suite.Registry)
+
+	first, firstErr := store.Insert("Company", map[string]any{"Name": "First"})
+	explicit, explicitErr := store.Insert("Company", map[string]any{"ID": 10, "Name": "Explicit"})
+	next, nextErr := store.Insert("Company", map[string]any{"Name": "Next"})
+
+	suite.Require().NoError(firstErr)
+	suite.Require().NoError(explicitErr)
+	suite.Require().NoError(nextErr)
+	suite.Equal(int64(1), first["ID"])
+	suite.Equal(10, explicit["ID"])
+	suite.Equal(int64(11), next["ID"])
+}
+
+func (suite *StoreTestSuite) TestInsert_Constraints() {
+	store := suite.getPopulatedStore()
+
+	_, invalidErr := store.Insert("Company", map[string]any{"Name": 1})
+	_, duplicateErr := store.Insert("Company", map[string]any{"Name": "Analytical Engines"})
+	_, duplicatePrimaryErr := store.Insert("Company", map[string]any{"ID": 1.0, "Name": "Other"})
+	_, duplicateCompositeErr := store.Insert("Person", map[string]any{"UUID": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "FirstName": "Ada", "LastName": "Lovelace", "EmployerID": 1})
+	_, missingRelationErr := store.Insert("Person", map[string]any{"UUID": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "FirstName": "Alan"})
+	_, unknownTargetErr := store.Insert("Person", map[string]any{"UUID": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "FirstName": "Alan", "EmployerID": 7})
+
+	suite.ErrorContains(invalidErr, "field 'Name' expected string, got int")
+	suite.ErrorContains(duplicateErr, "model 'Company' already has a record with identifier 'name' value [Analytical Engines]")
+	suite.ErrorContains(duplicatePrimaryErr, "model 'Company' already has a record with identifier 'primary' value [1]")
+	suite.ErrorContains(duplicateCompositeErr, "model 'Person' already has a record with identifier 'name' value [Ada Lovelace]")
+	suite.ErrorContains(missingRelationErr, "model 'Person' relation 'Employer' is required, but 'EmployerID' is not set")
+	suite.ErrorContains(unknownTargetErr, "model 'Person' relation 'Employer' references missing 'Company' record with identifier '7'")
+}
+
+func (suite *StoreTestSuite) TestGetByIdentifier() {
+	store := suite.getPopulatedStore()
+
+	byPrimary, primaryErr := store.Get("Person", 1)
+	byName, nameErr := store.GetByIdentifier("Person", "name", []any{"Ada", "Lovelace"})
+	_, missingErr := store.Get("Person", 2)
+	_, unknownErr := store.GetByIdentifier("Person", "email", "ada@example.com")
+
+	suite.Require().NoError(primaryErr)
+	suite.Require().NoError(nameErr)
+	suite.Equal(byPrimary, byName)
+	suite.Equal("Ada", byPrimary["FirstName"])
+	suite.ErrorContains(missingErr, "model 'Person' record with identifier '2' not found")
+	suite.ErrorContains(unknownErr, "model 'Person' has no identifier 'email'")
+}
+
+func (suite *StoreTestSuite) TestGet_ReturnsCopies() {
+	store := suite.getPopulatedStore()
+
+	record, getErr := store.Get("Person", 1)
+	suite.Require().NoError(getErr)
+	record["FirstName"] = "Changed"
+
+	stored, _ := store.Get("Person", 1)
+	suite.Equal("Ada", stored["FirstName"])
+}
+
+func (suite *StoreTestSuite) TestUpdate() {
+	store := suite.getPopulatedStore()
+
+	updated, updateErr := store.Update("Person", 1, map[string]any{"FirstName": "Augusta", "LastName": nil})
+	_, immutableErr := store.Update("Person", 1, map[string]any{"UUID": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"})
+	_, primaryErr := store.Update("Person", 1, map[string]any{"ID": 2})
+	_, relationErr := store.Update("Person", 1, map[string]any{"EmployerID": nil})
+
+	suite.Require().NoError(updateErr)
+	suite.Equal("Augusta", updated["FirstName"])
+	suite.NotContains(updated, "LastName")
+	suite.ErrorContains(immutableErr, "model 'Person' field 'UUID' is immutable")
+	suite.ErrorContains(primaryErr, "model 'Person' field 'ID' is immutable")
+	suite.ErrorContains(relationErr, "model 'Person' relation 'Employer' is required")
+}
+
+func (suite *StoreTestSuite) TestUpdate_UnchangedImmutableNumbers() {
+	store := suite.getPopulatedStore()
+
+	sameID, sameIDErr := store.Update("Person", 1, map[string]any{"ID": 1})
+	sameJSONID, sameJSONIDErr := store.Update("Person", 1, map[string]any{"ID": 1.0, "UUID": "3f2504e0-4f89-11d3-9a0c-0305e82c3301"})
+	_, changedErr := store.Update("Person", 1, map[string]any{"ID": 1.5})
+
+	suite.Require().NoError(sameIDErr)
+	suite.Require().NoError(sameJSONIDErr)
+	suite.Equal(int64(1), sameID["ID"])
+	suite.Equal(int64(1), sameJSONID["ID"])
+	suite.ErrorContains(changedErr, "model 'Person' field 'ID' is immutable")
+}
+
+func (suite *StoreTestSuite) TestTraverse() {
+	store := suite.getPopulatedStore()
+
+	employer, employerErr := store.Traverse("Person", 1, "Employer")
+	employees, employeesErr := store.Traverse("Company", 1, "Employee")
+	contactInfo, contactErr := store.Traverse("Person", 1, "ContactInfo")
+	_, unknownErr := store.Traverse("Person", 1, "Unknown")
+
+	suite.Require().NoError(employerErr)
+	suite.Require().NoError(employeesErr)
+	suite.Require().NoError(contactErr)
+	suite.Require().Len(employer, 1)
+	suite.Equal("Analytical Engines", employer[0]["Name"])
+	suite.Require().Len(employees, 1)
+	suite.Equal("Ada", employees[0]["FirstName"])
+	suite.Require().Len(contactInfo, 1)
+	suite.Equal("ada@example.com", contactInfo[0]["Email"])
+	suite.ErrorContains(unknownErr, "model 'Person' has no relation 'Unknown'")
+}
+
+func (suite *StoreTestSuite) TestDelete_OnDeleteActions() {
+	store := suite.getPopulatedStore()
+
+	deleteErr := store.Delete("Company", 1)
+
+	suite.Require().NoError(deleteErr)
+	people, _ := store.List("Person")
+	contacts, _ := store.List("ContactInfo")
+	suite.Empty(people)
+	suite.Require().Len(contacts, 1)
+	suite.NotContains(contacts[0], "PersonID")
+}
+
+func (suite *StoreTestSuite) TestDelete_Restricted() {
+	store := suite.getPopulatedStore()
+	_, orderErr := store.Insert("Order", map[string]any{"PersonID": 1})
+	suite.Require().NoError(orderErr)
+
+	deleteErr := store.Delete("Company", 1)
+
+	suite.ErrorContains(deleteErr, "model 'Person' record with identifier '1' is referenced by 'Order' relation 'Person'")
+	companies, _ := store.List("Company")
+	contacts, _ := store.List("ContactInfo")
+	suite.Len(companies, 1)
+	suite.Equal(1, contacts[0]["PersonID"])
+}
+
+func (suite *StoreTestSuite) TestAsDataSource_Projection() {
+	store := suite.getPopulatedStore()
+
+	entityRecord, projectErr := projection.NewProjector(suite.Registry, store.AsDataSource()).ProjectByID("Person", 1)
+
+	suite.Require().NoError(projectErr)
+	suite.Equal(int64(1), entityRecord["ID"])
+	suite.Equal("Ada Lovelace", entityRecord["FullName"])
+	suite.Equal("Analytical Engines", entityRecord["EmployerName"])
+	suite.Equal("ada@example.com", entityRecord["Email"])
+	suite.Equal(int64(0), entityRecord["OrderCount"])
+}
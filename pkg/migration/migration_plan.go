@@ -0,0 +1,395 @@
+package migration
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// PlanOptions configures how a migration plan is derived
+type PlanOptions struct {
+	Hints RenameHints
+
+	// DetectRenames pairs a model's single removed field with its single added field as a rename
+	// when both have the same type and attributes
+	DetectRenames bool
+}
+
+// MigrationPlan is an ordered list of abstract migration operations that transforms the before into the after schema.
+// Structures and entities carry no storage of their own and are not part of the plan.
+type MigrationPlan struct {
+	Operations []Operation `json:"operations"`
+}
+
+// IsEmpty returns true if the plan has no operations
+func (p MigrationPlan) IsEmpty() bool {
+	return len(p.Operations) == 0
+}
+
+// ToJSON serialises the plan as indented JSON
+func (p MigrationPlan) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
+
+// planPhase orders operations so that every operation only depends on the results of earlier ones
+type planPhase int
+
+const (
+	planPhaseDropRelations planPhase = iota
+	planPhaseDropIdentifiers
+	planPhaseEnums
+	planPhaseModels
+	planPhaseFields
+	planPhaseDropFields
+	planPhaseAddIdentifiers
+	planPhaseAddRelations
+	planPhaseDropModels
+	planPhaseDropEnums
+)
+
+type phasedOperation struct {
+	phase     planPhase
+	operation Operation
+}
+
+type planner struct {
+	options    PlanOptions
+	operations []phasedOperation
+}
+
+// Plan derives the migration plan between the models and enums of two registries
+func Plan(before *registry.Registry, after *registry.Registry, options PlanOptions) (MigrationPlan, error) {
+	if before == nil {
+		before = registry.NewRegistry()
+	}
+	if after == nil {
+		after = registry.NewRegistry()
+	}
+
+	p := planner{
+		options: options,
+	}
+	p.planEnums(before.GetAllEnums(), after.GetAllEnums())
+	if modelsErr := p.planModels(before.GetAllModels(), after.GetAllModels()); modelsErr != nil {
+		return MigrationPlan{}, modelsErr
+	}
+
+	// Operations were added in a deterministic order, a stable sort keeps it within each phase
+	sort.SliceStable(p.operations, func(i, j int) bool {
+		return p.operations[i].phase < p.operations[j].phase
+	})
+
+	plan := MigrationPlan{
+		Operations: make([]Operation, 0, len(p.operations)),
+	}
+	for _, phased := range p.operations {
+		plan.Operations = append(plan.Operations, phased.operation)
+	}
+	return plan, nil
+}
+
+func (p *planner) add(phase planPhase, operation Operation) {
+	p.operations = append(p.operations, phasedOperation{phase: phase, operation: operation})
+}
+
+func (p *planner) planEnums(before map[string]yaml.Enum, after map[string]yaml.Enum) {
+	for _, enumDiff := range registrydiff.CompareEnums(before, after) {
+		switch enumDiff.Change {
+		case registrydiff.ChangeTypeAdded:
+			enumDefinition := after[enumDiff.Name]
+			p.add(planPhaseEnums, Operation{Type: OperationTypeCreateEnum, Enum: enumDiff.Name, EnumDefinition: &enumDefinition})
+		case registrydiff.ChangeTypeRemoved:
+			p.add(planPhaseDropEnums, Operation{Type: OperationTypeDropEnum, Enum: enumDiff.Name})
+		case registrydiff.ChangeTypeChanged:
+			p.planEnumMembers(enumDiff, before[enumDiff.Name], after[enumDiff.Name])
+		}
+	}
+}
+
+func (p *planner) planEnumMembers(enumDiff registrydiff.DefinitionDiff, before yaml.Enum, after yaml.Enum) {
+	for _, member := range enumDiff.Members {
+		operation := Operation{Enum: enumDiff.Name, Name: member.Name}
+		phase := planPhaseEnums
+		switch {
+		case member.Member == registrydiff.MemberKindEnumType:
+			operation.Name = ""
+			operation.Type = OperationTypeChangeEnumType
+			operation.EnumDefinition = &after
+			operation.PreviousEnumType = before.Type
+		case member.Member != registrydiff.MemberKindEnumEntry:
+			// Kind, order and deprecation changes do not change the stored values
+			continue
+		case member.Change == registrydiff.ChangeTypeAdded:
+			operation.Type = OperationTypeAddEnumEntry
+			operation.EnumEntryValue = member.After
+		case member.Change == registrydiff.ChangeTypeRemoved:
+			operation.Type = OperationTypeDropEnumEntry
+			operation.PreviousEntryValue = member.Before
+			phase = planPhaseDropEnums
+		default:
+			operation.Type = OperationTypeChangeEnumEntry
+			operation.EnumEntryValue = member.After
+			operation.PreviousEntryValue = member.Before
+		}
+		p.add(phase, operation)
+	}
+}
+
+func (p *planner) planModels(before map[string]yaml.Model, after map[string]yaml.Model) error {
+	alignedBefore, renamesErr := p.planModelRenames(before, after)
+	if renamesErr != nil {
+		return renamesErr
+	}
+
+	for _, modelDiff := range registrydiff.CompareModels(alignedBefore, after) {
+		switch modelDiff.Change {
+		case registrydiff.ChangeTypeAdded:
+			p.planCreateModel(after[modelDiff.Name])
+		case registrydiff.ChangeTypeRemoved:
+			p.add(planPhaseDropModels, Operation{Type: OperationTypeDropModel, Model: modelDiff.Name})
+		case registrydiff.ChangeTypeChanged:
+			membersErr := p.planModelMembers(modelDiff, alignedBefore[modelDiff.Name], after[modelDiff.Name])
+			if membersErr != nil {
+				return membersErr
+			}
+		}
+	}
+	return nil
+}
+
+// planModelRenames adds rename operations and returns the before models keyed by their new names
+func (p *planner) planModelRenames(before map[string]yaml.Model, after map[string]yaml.Model) (map[string]yaml.Model, error) {
+	alignedBefore := make(map[string]yaml.Model, len(before))
+	for modelName, model := range before {
+		alignedBefore[modelName] = model
+	}
+
+	renamedFrom := map[string]bool{}
+	for _, modelName := range core.MapKeysSorted(p.options.Hints.Models) {
+		previousName := p.options.Hints.Models[modelName]
+		_, isAdded := after[modelName]
+		_, wasPresent := before[previousName]
+		_, isStillPresent := after[previousName]
+		if !isAdded || !wasPresent || isStillPresent {
+			return nil, ErrUnknownRenamedModel(modelName, previousName)
+		}
+		if renamedFrom[previousName] {
+			return nil, ErrConflictingRename(modelName, previousName)
+		}
+		renamedFrom[previousName] = true
+
+		alignedBefore[modelName] = before[previousName]
+		delete(alignedBefore, previousName)
+		p.add(planPhaseModels, Operation{Type: OperationTypeRenameModel, Model: modelName, PreviousName: previousName})
+	}
+	return alignedBefore, nil
+}
+
+// planCreateModel creates the model with its fields and identifiers, its relations are added once all models exist
+func (p *planner) planCreateModel(model yaml.Model) {
+	modelDefinition := model.DeepClone()
+	modelDefinition.Related = nil
+	p.add(planPhaseModels, Operation{Type: OperationTypeCreateModel, Model: model.Name, ModelDefinition: &modelDefinition})
+
+	for _, relationName := range core.MapKeysSorted(model.Related) {
+		relation := model.Related[relationName]
+		p.add(planPhaseAddRelations, Operation{Type: OperationTypeAddRelation, Model: model.Name, Name: relationName, Relation: &relation})
+	}
+}
+
+func (p *planner) planModelMembers(modelDiff registrydiff.DefinitionDiff, before yaml.Model, after yaml.Model) error {
+	renames, renamesErr := p.getFieldRenames(modelDiff, before, after)
+	if renamesErr != nil {
+		return renamesErr
+	}
+	renamedFieldNames := []string{}
+	for _, fieldName := range core.MapKeysSorted(renames) {
+		previousName := renames[fieldName]
+		p.planRenameField(modelDiff.Name, fieldName, before.Fields[previousName], after.Fields[fieldName], previousName)
+		renamedFieldNames = append(renamedFieldNames, fieldName, previousName)
+	}
+
+	changedRelations := map[string]bool{}
+	for _, member := range modelDiff.Members {
+		isRenameMember := member.Member == registrydiff.MemberKindField && slices.Contains(renamedFieldNames, member.Name)
+		if isRenameMember {
+			continue
+		}
+
+		switch member.Member {
+		case registrydiff.MemberKindField:
+			p.planFieldMember(modelDiff.Name, member, before, after)
+		case registrydiff.MemberKindAttribute:
+			p.planAttributeMember(modelDiff.Name, member)
+		case registrydiff.MemberKindIdentifier:
+			p.planIdentifierMember(modelDiff.Name, member, before, after)
+		case registrydiff.MemberKindRelation:
+			if changedRelations[member.Name] {
+				continue
+			}
+			changedRelations[member.Name] = true
+			p.planRelationMember(modelDiff.Name, member, before, after)
+		}
+	}
+	return nil
+}
+
+// getFieldRenames maps renamed field names to their previous names, from hints, 'renamedFrom' keys and detection
+func (p *planner) getFieldRenames(modelDiff registrydiff.DefinitionDiff, before yaml.Model, after yaml.Model) (map[string]string, error) {
+	addedFields := []string{}
+	removedFields := []string{}
+	for _, member := range modelDiff.Members {
+		if member.Member != registrydiff.MemberKindField {
+			continue
+		}
+		switch member.Change {
+		case registrydiff.ChangeTypeAdded:
+			addedFields = append(addedFields, member.Name)
+		case registrydiff.ChangeTypeRemoved:
+			removedFields = append(removedFields, member.Name)
+		}
+	}
+
+	renames := map[string]string{}
+	renamedFrom := map[string]bool{}
+	for _, fieldName := range addedFields {
+		previousName := p.options.Hints.getFieldRename(modelDiff.Name, fieldName)
+		if previousName == "" {
+			previousName = after.Fields[fieldName].RenamedFrom
+		}
+		if previousName == "" {
+			continue
+		}
+		if !slices.Contains(removedFields, previousName) {
+			return nil, ErrUnknownRenamedField(modelDiff.Name, fieldName, previousName)
+		}
+		if renamedFrom[previousName] {
+			return nil, ErrConflictingRename(modelDiff.Name, previousName)
+		}
+		renamedFrom[previousName] = true
+		renames[fieldName] = previousName
+	}
+
+	if !p.options.DetectRenames {
+		return renames, nil
+	}
+
+	unmatchedAdded := []string{}
+	for _, fieldName := range addedFields {
+		if _, isRenamed := renames[fieldName]; !isRenamed {
+			unmatchedAdded = append(unmatchedAdded, fieldName)
+		}
+	}
+	unmatchedRemoved := []string{}
+	for _, fieldName := range removedFields {
+		if !renamedFrom[fieldName] {
+			unmatchedRemoved = append(unmatchedRemoved, fieldName)
+		}
+	}
+	if len(unmatchedAdded) != 1 || len(unmatchedRemoved) != 1 {
+		return renames, nil
+	}
+
+	addedField := after.Fields[unmatchedAdded[0]]
+	removedField := before.Fields[unmatchedRemoved[0]]
+	isSameType := addedField.Type == removedField.Type
+	isSameAttributes := slices.Equal(getSortedAttributes(addedField), getSortedAttributes(removedField))
+	if isSameType && isSameAttributes {
+		renames[unmatchedAdded[0]] = unmatchedRemoved[0]
+	}
+	return renames, nil
+}
+
+func getSortedAttributes(field yaml.ModelField) []string {
+	attributes := slices.Clone(field.Attributes)
+	slices.Sort(attributes)
+	return attributes
+}
+
+func (p *planner) planRenameField(modelName string, fieldName string, before yaml.ModelField, after yaml.ModelField, previousName string) {
+	p.add(planPhaseFields, Operation{Type: OperationTypeRenameField, Model: modelName, Name: fieldName, PreviousName: previousName})
+
+	if before.Type != after.Type {
+		fieldDefinition := after.DeepClone()
+		p.add(planPhaseFields, Operation{
+			Type:              OperationTypeChangeFieldType,
+			Model:             modelName,
+			Name:              fieldName,
+			FieldDefinition:   &fieldDefinition,
+			PreviousFieldType: before.Type,
+		})
+	}
+
+	for _, attribute := range after.Attributes {
+		if !slices.Contains(before.Attributes, attribute) {
+			p.add(planPhaseFields, Operation{Type: OperationTypeAddAttribute, Model: modelName, Field: fieldName, Name: attribute})
+		}
+	}
+	for _, attribute := range before.Attributes {
+		if !slices.Contains(after.Attributes, attribute) {
+			p.add(planPhaseFields, Operation{Type: OperationTypeDropAttribute, Model: modelName, Field: fieldName, Name: attribute})
+		}
+	}
+}
+
+func (p *planner) planFieldMember(modelName string, member registrydiff.MemberChange, before yaml.Model, after yaml.Model) {
+	switch member.Change {
+	case registrydiff.ChangeTypeAdded:
+		fieldDefinition := after.Fields[member.Name].DeepClone()
+		p.add(planPhaseFields, Operation{Type: OperationTypeAddField, Model: modelName, Name: member.Name, FieldDefinition: &fieldDefinition})
+	case registrydiff.ChangeTypeRemoved:
+		p.add(planPhaseDropFields, Operation{Type: OperationTypeDropField, Model: modelName, Name: member.Name})
+	case registrydiff.ChangeTypeChanged:
+		fieldDefinition := after.Fields[member.Name].DeepClone()
+		p.add(planPhaseFields, Operation{
+			Type:              OperationTypeChangeFieldType,
+			Model:             modelName,
+			Name:              member.Name,
+			FieldDefinition:   &fieldDefinition,
+			PreviousFieldType: before.Fields[member.Name].Type,
+		})
+	}
+}
+
+func (p *planner) planAttributeMember(modelName string, member registrydiff.MemberChange) {
+	operationType := OperationTypeAddAttribute
+	if member.Change == registrydiff.ChangeTypeRemoved {
+		operationType = OperationTypeDropAttribute
+	}
+	p.add(planPhaseFields, Operation{Type: operationType, Model: modelName, Field: member.Field, Name: member.Name})
+}
+
+func (p *planner) planIdentifierMember(modelName string, member registrydiff.MemberChange, before yaml.Model, after yaml.Model) {
+	if member.Change != registrydiff.ChangeTypeAdded {
+		p.add(planPhaseDropIdentifiers, Operation{Type: OperationTypeDropIdentifier, Model: modelName, Name: member.Name})
+	}
+	if member.Change != registrydiff.ChangeTypeRemoved {
+		identifier := after.Identifiers[member.Name].DeepClone()
+		p.add(planPhaseAddIdentifiers, Operation{Type: OperationTypeAddIdentifier, Model: modelName, Name: member.Name, Identifier: &identifier})
+	}
+}
+
+func (p *planner) planRelationMember(modelName string, member registrydiff.MemberChange, before yaml.Model, after yaml.Model) {
+	switch member.Change {
+	case registrydiff.ChangeTypeAdded:
+		relation := after.Related[member.Name].DeepClone()
+		p.add(planPhaseAddRelations, Operation{Type: OperationTypeAddRelation, Model: modelName, Name: member.Name, Relation: &relation})
+	case registrydiff.ChangeTypeRemoved:
+		p.add(planPhaseDropRelations, Operation{Type: OperationTypeDropRelation, Model: modelName, Name: member.Name})
+	case registrydiff.ChangeTypeChanged:
+		relation := after.Related[member.Name].DeepClone()
+		previousRelation := before.Related[member.Name].DeepClone()
+		p.add(planPhaseAddRelations, Operation{
+			Type:             OperationTypeChangeRelation,
+			Model:            modelName,
+			Name:             member.Name,
+			Relation:         &relation,
+			PreviousRelation: &previousRelation,
+		})
+	}
+}
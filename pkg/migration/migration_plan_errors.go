@@ -0,0 +1,15 @@
+package migration
+
+import "fmt"
+
+func ErrUnknownRenamedModel(modelName string, previousName string) error {
+	return fmt.Errorf("model '%s' is renamed from '%s', which is not a removed model", modelName, previousName)
+}
+
+func ErrUnknownRenamedField(modelName string, fieldName string, previousName string) error {
+	return fmt.Errorf("model '%s' field '%s' is renamed from '%s', which is not a removed field", modelName, fieldName, previousName)
+}
+
+func ErrConflictingRename(modelName string, previousName string) error {
+	return fmt.Errorf("model '%s' has multiple renames from '%s'", modelName, previousName)
+}
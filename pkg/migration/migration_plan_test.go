@@ -0,0 +1,327 @@
+package migration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kalo-build/morphe-go/pkg/migration"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func getPlanRegistry(models ...yaml.Model) *registry.Registry {
+	r := registry.NewRegistry()
+	for _, model := range models {
+		r.SetModel(model.Name, model)
+	}
+	return r
+}
+
+func getPersonModel(fields map[string]yaml.ModelField) yaml.Model {
+	allFields := map[string]yaml.ModelField{
+		"ID": {Type: yaml.ModelFieldTypeAutoIncrement},
+	}
+	for fieldName, field := range fields {
+		allFields[fieldName] = field
+	}
+	return yaml.Model{
+		Name:   "Person",
+		Fields: allFields,
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+}
+
+func getOperationTypes(plan migration.MigrationPlan) []migration.OperationType {
+	operationTypes := []migration.OperationType{}
+	for _, operation := range plan.Operations {
+		operationTypes = append(operationTypes, operation.Type)
+	}
+	return operationTypes
+}
+
+func TestPlan_NoChanges(t *testing.T) {
+	person := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(person), getPlanRegistry(person), migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	assert.True(t, plan.IsEmpty())
+}
+
+func TestPlan_FieldRemovedAndAddedWithoutRename(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeAddField, migration.OperationTypeDropField}, getOperationTypes(plan))
+	assert.Equal(t, "FullName", plan.Operations[0].Name)
+	assert.Equal(t, "Name", plan.Operations[1].Name)
+}
+
+func TestPlan_FieldRenamedFrom(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString, RenamedFrom: "Name"}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	require.Len(t, plan.Operations, 1)
+	assert.Equal(t, migration.Operation{
+		Type:         migration.OperationTypeRenameField,
+		Model:        "Person",
+		Name:         "FullName",
+		PreviousName: "Name",
+	}, plan.Operations[0])
+}
+
+func TestPlan_FieldRenameHint(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString, Attributes: []string{"mandatory"}}})
+	options := migration.PlanOptions{
+		Hints: migration.RenameHints{
+			Fields: map[string]map[string]string{"Person": {"FullName": "Name"}},
+		},
+	}
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), options)
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeRenameField, migration.OperationTypeAddAttribute}, getOperationTypes(plan))
+	assert.Equal(t, "FullName", plan.Operations[1].Field)
+	assert.Equal(t, "mandatory", plan.Operations[1].Name)
+}
+
+func TestPlan_FieldRenamedWithTypeChange(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Years": {Type: yaml.ModelFieldTypeInteger}})
+	after := getPersonModel(map[string]yaml.ModelField{"Age": {Type: yaml.ModelFieldTypeFloat, RenamedFrom: "Years"}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeRenameField, migration.OperationTypeChangeFieldType}, getOperationTypes(plan))
+	assert.Equal(t, yaml.ModelFieldTypeInteger, plan.Operations[1].PreviousFieldType)
+	require.NotNil(t, plan.Operations[1].FieldDefinition)
+	assert.Equal(t, yaml.ModelFieldTypeFloat, plan.Operations[1].FieldDefinition.Type)
+}
+
+func TestPlan_DetectRenames(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{DetectRenames: true})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeRenameField}, getOperationTypes(plan))
+}
+
+func TestPlan_DetectRenames_DifferentTypes(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"Age": {Type: yaml.ModelFieldTypeInteger}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{DetectRenames: true})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeAddField, migration.OperationTypeDropField}, getOperationTypes(plan))
+}
+
+func TestPlan_DetectRenames_Ambiguous(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{
+		"First": {Type: yaml.ModelFieldTypeString},
+		"Last":  {Type: yaml.ModelFieldTypeString},
+	})
+	after := getPersonModel(map[string]yaml.ModelField{
+		"GivenName":  {Type: yaml.ModelFieldTypeString},
+		"FamilyName": {Type: yaml.ModelFieldTypeString},
+	})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{DetectRenames: true})
+
+	require.NoError(t, planErr)
+	assert.NotContains(t, getOperationTypes(plan), migration.OperationTypeRenameField)
+}
+
+func TestPlan_UnknownRenamedField(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString, RenamedFrom: "Title"}})
+
+	_, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+
+	assert.ErrorContains(t, planErr, "model 'Person' field 'FullName' is renamed from 'Title', which is not a removed field")
+}
+
+func TestPlan_ConflictingFieldRenames(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{
+		"FirstName": {Type: yaml.ModelFieldTypeString},
+		"LastName":  {Type: yaml.ModelFieldTypeString, RenamedFrom: "Name"},
+	})
+	options := migration.PlanOptions{
+		Hints: migration.RenameHints{
+			Fields: map[string]map[string]string{"Person": {"FirstName": "Name"}},
+		},
+	}
+
+	_, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), options)
+
+	assert.ErrorContains(t, planErr, "model 'Person' has multiple renames from 'Name'")
+}
+
+func TestPlan_ModelRenameHint(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := before.DeepClone()
+	after.Name = "Customer"
+	after.Fields["Email"] = yaml.ModelField{Type: yaml.ModelFieldTypeString}
+	options := migration.PlanOptions{
+		Hints: migration.RenameHints{
+			Models: map[string]string{"Customer": "Person"},
+		},
+	}
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), options)
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeRenameModel, migration.OperationTypeAddField}, getOperationTypes(plan))
+	assert.Equal(t, "Person", plan.Operations[0].PreviousName)
+	assert.Equal(t, "Customer", plan.Operations[1].Model)
+}
+
+func TestPlan_UnknownRenamedModel(t *testing.T) {
+	person := getPersonModel(nil)
+	options := migration.PlanOptions{
+		Hints: migration.RenameHints{
+			Models: map[string]string{"Customer": "Person"},
+		},
+	}
+
+	_, planErr := migration.Plan(getPlanRegistry(person), getPlanRegistry(person), options)
+
+	assert.ErrorContains(t, planErr, "model 'Customer' is renamed from 'Person', which is not a removed model")
+}
+
+func TestPlan_OperationOrder(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetEnum("Legacy", yaml.Enum{Name: "Legacy", Type: yaml.EnumTypeInteger, Entries: map[string]any{"A": 1}})
+	before.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":       {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Nickname": {Type: yaml.ModelFieldTypeString},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+			"nick":    {Fields: []string{"Nickname"}},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Team": {Type: "ForOne"},
+		},
+	})
+	before.SetModel("Team", yaml.Model{
+		Name:        "Team",
+		Fields:      map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}},
+		Identifiers: map[string]yaml.ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+	})
+
+	after := registry.NewRegistry()
+	after.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American"}})
+	after.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":          {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Nationality": {Type: "Nationality"},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Company": {Type: "ForOne"},
+		},
+	})
+	after.SetModel("Company", yaml.Model{
+		Name:        "Company",
+		Fields:      map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}},
+		Identifiers: map[string]yaml.ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+		Related: map[string]yaml.ModelRelation{
+			"Person": {Type: "HasMany"},
+		},
+	})
+
+	plan, planErr := migration.Plan(before, after, migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{
+		migration.OperationTypeDropRelation,
+		migration.OperationTypeDropIdentifier,
+		migration.OperationTypeCreateEnum,
+		migration.OperationTypeCreateModel,
+		migration.OperationTypeAddField,
+		migration.OperationTypeDropField,
+		migration.OperationTypeAddRelation,
+		migration.OperationTypeAddRelation,
+		migration.OperationTypeDropModel,
+		migration.OperationTypeDropEnum,
+	}, getOperationTypes(plan))
+
+	createModel := plan.Operations[3]
+	require.NotNil(t, createModel.ModelDefinition)
+	assert.Equal(t, "Company", createModel.Model)
+	assert.Empty(t, createModel.ModelDefinition.Related)
+	assert.Equal(t, "Company", plan.Operations[6].Model)
+	assert.Equal(t, "Person", plan.Operations[7].Model)
+	assert.Equal(t, "Team", plan.Operations[8].Model)
+}
+
+func TestPlan_ChangedRelation(t *testing.T) {
+	before := getPersonModel(nil)
+	before.Related = map[string]yaml.ModelRelation{"Company": {Type: "ForOne"}}
+	after := before.DeepClone()
+	after.Related = map[string]yaml.ModelRelation{"Company": {Type: "ForOne", Required: true, OnDelete: yaml.ModelRelationOnDeleteCascade}}
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	require.Len(t, plan.Operations, 1)
+	operation := plan.Operations[0]
+	assert.Equal(t, migration.OperationTypeChangeRelation, operation.Type)
+	require.NotNil(t, operation.PreviousRelation)
+	require.NotNil(t, operation.Relation)
+	assert.False(t, operation.PreviousRelation.Required)
+	assert.True(t, operation.Relation.Required)
+}
+
+func TestPlan_ToJSON(t *testing.T) {
+	before := getPersonModel(map[string]yaml.ModelField{"Name": {Type: yaml.ModelFieldTypeString}})
+	after := getPersonModel(map[string]yaml.ModelField{"FullName": {Type: yaml.ModelFieldTypeString, RenamedFrom: "Name"}})
+
+	plan, planErr := migration.Plan(getPlanRegistry(before), getPlanRegistry(after), migration.PlanOptions{})
+	require.NoError(t, planErr)
+
+	planJSON, jsonErr := plan.ToJSON()
+
+	require.NoError(t, jsonErr)
+	assert.Contains(t, string(planJSON), `"type": "renameField"`)
+	assert.Contains(t, string(planJSON), `"previousName": "Name"`)
+}
+
+func TestPlan_EnumMetadataChanges(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetEnum("Level", yaml.Enum{Name: "Level", Type: yaml.EnumTypeInteger, Kind: yaml.EnumKindOrdered, Entries: map[string]any{"Low": 1, "High": 2}, EntryOrder: []string{"Low", "High"}})
+	after := registry.NewRegistry()
+	after.SetEnum("Level", yaml.Enum{
+		Name:              "Level",
+		Type:              yaml.EnumTypeInteger,
+		Entries:           map[string]any{"Low": 1, "High": 2, "Max": 3},
+		EntryOrder:        []string{"High", "Low", "Max"},
+		DeprecatedEntries: map[string]yaml.Deprecation{"Low": {Since: "2.0"}},
+	})
+
+	plan, planErr := migration.Plan(before, after, migration.PlanOptions{})
+
+	require.NoError(t, planErr)
+	assert.Equal(t, []migration.OperationType{migration.OperationTypeAddEnumEntry}, getOperationTypes(plan))
+}
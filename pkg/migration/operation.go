@@ -0,0 +1,58 @@
+package migration
+
+import "github.com/kalo-build/morphe-go/pkg/yaml"
+
+// OperationType is the kind of an abstract, backend independent migration operation
+type OperationType string
+
+const (
+	OperationTypeCreateEnum      OperationType = "createEnum"
+	OperationTypeDropEnum        OperationType = "dropEnum"
+	OperationTypeChangeEnumType  OperationType = "changeEnumType"
+	OperationTypeAddEnumEntry    OperationType = "addEnumEntry"
+	OperationTypeDropEnumEntry   OperationType = "dropEnumEntry"
+	OperationTypeChangeEnumEntry OperationType = "changeEnumEntry"
+	OperationTypeCreateModel     OperationType = "createModel"
+	OperationTypeDropModel       OperationType = "dropModel"
+	OperationTypeRenameModel     OperationType = "renameModel"
+	OperationTypeAddField        OperationType = "addField"
+	OperationTypeDropField       OperationType = "dropField"
+	OperationTypeRenameField     OperationType = "renameField"
+	OperationTypeChangeFieldType OperationType = "changeFieldType"
+	OperationTypeAddAttribute    OperationType = "addAttribute"
+	OperationTypeDropAttribute   OperationType = "dropAttribute"
+	OperationTypeAddIdentifier   OperationType = "addIdentifier"
+	OperationTypeDropIdentifier  OperationType = "dropIdentifier"
+	OperationTypeAddRelation     OperationType = "addRelation"
+	OperationTypeDropRelation    OperationType = "dropRelation"
+	OperationTypeChangeRelation  OperationType = "changeRelation"
+)
+
+// Operation is a single migration step. Only the properties relevant to its type are set.
+type Operation struct {
+	Type OperationType `json:"type"`
+
+	// Model or Enum is the definition the operation applies to
+	Model string `json:"model,omitempty"`
+	Enum  string `json:"enum,omitempty"`
+
+	// Name is the field, attribute, identifier, relation or enum entry name
+	Name string `json:"name,omitempty"`
+
+	// PreviousName is the former model or field name of a rename
+	PreviousName string `json:"previousName,omitempty"`
+
+	// Field is the field an attribute operation applies to
+	Field string `json:"field,omitempty"`
+
+	ModelDefinition    *yaml.Model           `json:"modelDefinition,omitempty"`
+	EnumDefinition     *yaml.Enum            `json:"enumDefinition,omitempty"`
+	FieldDefinition    *yaml.ModelField      `json:"fieldDefinition,omitempty"`
+	Identifier         *yaml.ModelIdentifier `json:"identifier,omitempty"`
+	Relation           *yaml.ModelRelation   `json:"relation,omitempty"`
+	PreviousRelation   *yaml.ModelRelation   `json:"previousRelation,omitempty"`
+	PreviousFieldType  yaml.ModelFieldType   `json:"previousFieldType,omitempty"`
+	PreviousEnumType   yaml.EnumType         `json:"previousEnumType,omitempty"`
+	EnumEntryValue     any                   `json:"enumEntryValue,omitempty"`
+	PreviousEntryValue any                   `json:"previousEntryValue,omitempty"`
+}
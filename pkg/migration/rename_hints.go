@@ -0,0 +1,19 @@
+package migration
+
+// RenameHints explicitly declare renamed definitions and fields, in addition to 'renamedFrom' keys on model fields
+type RenameHints struct {
+	// Models maps a new model name to its previous name
+	Models map[string]string
+
+	// Fields maps a model name to a map of new field names to their previous names
+	Fields map[string]map[string]string
+}
+
+// getFieldRename returns the explicit previous name of a model field, if any
+func (h RenameHints) getFieldRename(modelName string, fieldName string) string {
+	modelFields, hasModelFields := h.Fields[modelName]
+	if !hasModelFields {
+		return ""
+	}
+	return modelFields[fieldName]
+}
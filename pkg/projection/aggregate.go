@@ -0,0 +1,106 @@
+package projection
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// aggregate computes an aggregate function over the non-nil values of a to-many path.
+// Sums of no values are zero, the other functions yield nil.
+func aggregate(entityName string, fieldName string, function yaml.EntityFieldFunction, resultType yaml.ModelFieldType, values []any) (any, error) {
+	switch function {
+	case yaml.EntityFieldFunctionSum, yaml.EntityFieldFunctionAvg:
+		sum := 0.0
+		for _, value := range values {
+			number, isNumber := toFloat(value)
+			if !isNumber {
+				return nil, ErrUnsupportedAggregateValue(entityName, fieldName, value)
+			}
+			sum += number
+		}
+		if function == yaml.EntityFieldFunctionAvg {
+			if len(values) == 0 {
+				return nil, nil
+			}
+			return sum / float64(len(values)), nil
+		}
+		if resultType == yaml.ModelFieldTypeInteger {
+			return int64(sum), nil
+		}
+		return sum, nil
+	}
+
+	var extreme any
+	for _, value := range values {
+		if extreme == nil {
+			extreme = value
+			continue
+		}
+		comparison, compareErr := compareValues(value, extreme)
+		if compareErr != nil {
+			return nil, ErrUnsupportedAggregateValue(entityName, fieldName, value)
+		}
+		if (function == yaml.EntityFieldFunctionMin && comparison < 0) || (function == yaml.EntityFieldFunctionMax && comparison > 0) {
+			extreme = value
+		}
+	}
+	return extreme, nil
+}
+
+// compareValues orders numbers by value, and strings and times chronologically or lexically
+func compareValues(left any, right any) (int, error) {
+	leftNumber, isLeftNumber := toFloat(left)
+	rightNumber, isRightNumber := toFloat(right)
+	if isLeftNumber && isRightNumber {
+		return cmp.Compare(leftNumber, rightNumber), nil
+	}
+
+	leftTime, isLeftTime := left.(time.Time)
+	rightTime, isRightTime := right.(time.Time)
+	if isLeftTime && isRightTime {
+		return leftTime.Compare(rightTime), nil
+	}
+
+	leftString, isLeftString := left.(string)
+	rightString, isRightString := right.(string)
+	if isLeftString && isRightString {
+		return cmp.Compare(leftString, rightString), nil
+	}
+	return 0, fmt.Errorf("cannot compare %T with %T", left, right)
+}
+
+func formatConcatValue(value any) string {
+	if timeValue, isTime := value.(time.Time); isTime {
+		return timeValue.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
+
+func toFloat(value any) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue), true
+	case int32:
+		return float64(typedValue), true
+	case int64:
+		return float64(typedValue), true
+	case uint:
+		return float64(typedValue), true
+	case uint32:
+		return float64(typedValue), true
+	case uint64:
+		return float64(typedValue), true
+	case float32:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case json.Number:
+		floatValue, parseErr := typedValue.Float64()
+		return floatValue, parseErr == nil
+	}
+	return 0, false
+}
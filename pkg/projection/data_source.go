@@ -0,0 +1,13 @@
+package projection
+
+import "github.com/kalo-build/morphe-go/pkg/yaml"
+
+// DataSource provides the model records that entities are projected from
+type DataSource interface {
+	// GetRecord returns the record of a model with the specified identifier, or nil if it does not exist
+	GetRecord(modelName string, id any) (map[string]any, error)
+
+	// GetRelated returns the records related to a record through a relation hop.
+	// The hop carries the aliased target model in ToModel, a missing ForOne target is an empty result.
+	GetRelated(hop yaml.ModelFieldPathHop, record map[string]any) ([]map[string]any, error)
+}
@@ -0,0 +1,27 @@
+package projection
+
+import "fmt"
+
+func ErrUnknownEntity(entityName string) error {
+	return fmt.Errorf("entity with name '%s' not found in registry", entityName)
+}
+
+func ErrRecordNotFound(modelName string, id any) error {
+	return fmt.Errorf("model '%s' record with identifier '%v' not found", modelName, id)
+}
+
+func ErrMixedRootModels(entityName string, fieldName string, rootModelName string, entityRootModelName string) error {
+	return fmt.Errorf("entity '%s' field '%s' starts at model '%s', but the entity is projected from model '%s'", entityName, fieldName, rootModelName, entityRootModelName)
+}
+
+func ErrMultipleRelatedRecords(modelName string, relationName string, count int) error {
+	return fmt.Errorf("model '%s' relation '%s' yields %d records, at most 1 expected", modelName, relationName, count)
+}
+
+func ErrDataSource(entityName string, fieldName string, sourceErr error) error {
+	return fmt.Errorf("error projecting entity '%s' field '%s': %w", entityName, fieldName, sourceErr)
+}
+
+func ErrUnsupportedAggregateValue(entityName string, fieldName string, value any) error {
+	return fmt.Errorf("cannot aggregate entity '%s' field '%s' value '%v' of type %T", entityName, fieldName, value, value)
+}
@@ -0,0 +1,165 @@
+package projection
+
+import (
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// Projector builds entity records from the model records of a data source
+type Projector struct {
+	source DataSource
+
+	enums      map[string]yaml.Enum
+	models     map[string]yaml.Model
+	structures map[string]yaml.Structure
+	entities   map[string]yaml.Entity
+}
+
+// NewProjector creates a projector from a snapshot of the registry definitions
+func NewProjector(r *registry.Registry, source DataSource) *Projector {
+	return &Projector{
+		source:     source,
+		enums:      r.GetAllEnums(),
+		models:     r.GetAllModels(),
+		structures: r.GetAllStructures(),
+		entities:   r.GetAllEntities(),
+	}
+}
+
+// GetRootModelName returns the model an entity is projected from
+func (p *Projector) GetRootModelName(entityName string) (string, error) {
+	entity, exists := p.entities[entityName]
+	if !exists {
+		return "", ErrUnknownEntity(entityName)
+	}
+	return entity.ResolveRootModelName(p.models, p.enums, p.structures)
+}
+
+// ProjectByID loads a root model record from the data source and projects it onto the entity
+func (p *Projector) ProjectByID(entityName string, id any) (map[string]any, error) {
+	rootModelName, rootErr := p.GetRootModelName(entityName)
+	if rootErr != nil {
+		return nil, rootErr
+	}
+	rootRecord, recordErr := p.source.GetRecord(rootModelName, id)
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	if rootRecord == nil {
+		return nil, ErrRecordNotFound(rootModelName, id)
+	}
+	return p.Project(entityName, rootRecord)
+}
+
+// Project projects a root model record onto the entity, resolving each entity field against the data source.
+// Fields reached through a missing ForOne target are nil.
+func (p *Projector) Project(entityName string, rootRecord map[string]any) (map[string]any, error) {
+	rootModelName, rootErr := p.GetRootModelName(entityName)
+	if rootErr != nil {
+		return nil, rootErr
+	}
+
+	entity := p.entities[entityName]
+	entityRecord := make(map[string]any, len(entity.Fields))
+	for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+		resolved, resolveErr := entity.ResolveFieldExpression(fieldName, p.models, p.enums, p.structures)
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		for _, resolvedPath := range resolved.Paths {
+			if resolvedPath.RootModel().Name != rootModelName {
+				return nil, ErrMixedRootModels(entityName, fieldName, resolvedPath.RootModel().Name, rootModelName)
+			}
+		}
+
+		value, valueErr := p.projectField(entityName, fieldName, resolved, rootRecord)
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		entityRecord[fieldName] = value
+	}
+	return entityRecord, nil
+}
+
+func (p *Projector) projectField(entityName string, fieldName string, resolved yaml.ResolvedEntityFieldExpression, rootRecord map[string]any) (any, error) {
+	expression := resolved.Expression
+	switch {
+	case !expression.IsComputed():
+		return p.projectPathValue(entityName, fieldName, resolved.Paths[0], rootRecord)
+
+	case expression.Function == yaml.EntityFieldFunctionConcat:
+		return p.projectConcat(entityName, fieldName, resolved, rootRecord)
+	}
+
+	records, recordsErr := p.followHops(entityName, fieldName, resolved.Paths[0].Hops, rootRecord)
+	if recordsErr != nil {
+		return nil, recordsErr
+	}
+	if expression.Function == yaml.EntityFieldFunctionCount {
+		return int64(len(records)), nil
+	}
+
+	values := []any{}
+	for _, record := range records {
+		if value := record[resolved.Paths[0].TerminalFieldName]; value != nil {
+			values = append(values, value)
+		}
+	}
+	return aggregate(entityName, fieldName, expression.Function, resolved.Type, values)
+}
+
+// projectPathValue returns the terminal field value of a to-one path, or nil if a related record is missing
+func (p *Projector) projectPathValue(entityName string, fieldName string, resolvedPath yaml.ResolvedModelFieldPath, rootRecord map[string]any) (any, error) {
+	records, recordsErr := p.followHops(entityName, fieldName, resolvedPath.Hops, rootRecord)
+	if recordsErr != nil {
+		return nil, recordsErr
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return records[0][resolvedPath.TerminalFieldName], nil
+}
+
+// projectConcat joins the literals and path values of a concat expression, missing values are skipped
+func (p *Projector) projectConcat(entityName string, fieldName string, resolved yaml.ResolvedEntityFieldExpression, rootRecord map[string]any) (any, error) {
+	var builder strings.Builder
+	pathIdx := 0
+	for _, argument := range resolved.Expression.Arguments {
+		if argument.IsLiteral {
+			builder.WriteString(argument.Literal)
+			continue
+		}
+		value, valueErr := p.projectPathValue(entityName, fieldName, resolved.Paths[pathIdx], rootRecord)
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		pathIdx++
+		if value != nil {
+			builder.WriteString(formatConcatValue(value))
+		}
+	}
+	return builder.String(), nil
+}
+
+// followHops walks the relation hops from the root record and returns the records reached by the last hop
+func (p *Projector) followHops(entityName string, fieldName string, hops []yaml.ModelFieldPathHop, rootRecord map[string]any) ([]map[string]any, error) {
+	records := []map[string]any{rootRecord}
+	for _, hop := range hops {
+		nextRecords := []map[string]any{}
+		for _, record := range records {
+			related, relatedErr := p.source.GetRelated(hop, record)
+			if relatedErr != nil {
+				return nil, ErrDataSource(entityName, fieldName, relatedErr)
+			}
+			if hop.Cardinality == yaml.RelationCardinalityOne && len(related) > 1 {
+				return nil, ErrMultipleRelatedRecords(hop.FromModel, hop.RelationName, len(related))
+			}
+			nextRecords = append(nextRecords, related...)
+		}
+		records = nextRecords
+	}
+	return records, nil
+}
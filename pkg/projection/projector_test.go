@@ -0,0 +1,180 @@
+package projection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/projection"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+)
+
+// testDataSource links records through the foreign key fields of the registry relations
+type testDataSource struct {
+	models  map[string]yaml.Model
+	records map[string][]map[string]any
+}
+
+func (s testDataSource) GetRecord(modelName string, id any) (map[string]any, error) {
+	for _, record := range s.records[modelName] {
+		if record["ID"] == id {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s testDataSource) GetRelated(hop yaml.ModelFieldPathHop, record map[string]any) ([]map[string]any, error) {
+	if _, exists := s.records[hop.ToModel]; !exists {
+		return nil, fmt.Errorf("no '%s' records", hop.ToModel)
+	}
+
+	related := []map[string]any{}
+	if yamlops.IsRelationFor(hop.Relation.Type) {
+		target, _ := s.GetRecord(hop.ToModel, record[yamlops.GetForRelationForeignKey(hop.RelationName, hop.Relation)])
+		if target != nil {
+			related = append(related, target)
+		}
+		return related, nil
+	}
+
+	foreignKey, foreignKeyErr := yamlops.GetHasRelationForeignKey(hop.FromModel, hop.Relation, s.models[hop.ToModel])
+	if foreignKeyErr != nil {
+		return nil, foreignKeyErr
+	}
+	for _, target := range s.records[hop.ToModel] {
+		if target[foreignKey] == record["ID"] {
+			related = append(related, target)
+		}
+	}
+	return related, nil
+}
+
+type ProjectorTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+	Source   testDataSource
+}
+
+func TestProjectorTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectorTestSuite))
+}
+
+func (suite *ProjectorTestSuite) SetupTest() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	suite.Registry = r
+	suite.Source = testDataSource{
+		models: r.GetAllModels(),
+		records: map[string][]map[string]any{
+			"Person": {
+				{"ID": 1, "FirstName": "Ada", "LastName": "Lovelace", "Nationality": "German", "SSN": "sealed", "EmployerID": 10},
+				{"ID": 2, "FirstName": "Alan", "LastName": "Turing", "EmployerID": 99, "MentorID": 1},
+			},
+			"Company": {
+				{"ID": 10, "Name": "Analytical Engines"},
+			},
+			"Office": {
+				{"ID": 20, "ZipCode": "EC1", "City": "London", "CompanyID": 10},
+			},
+			"ContactInfo": {
+				{"ID": 50, "Email": "ada@example.com", "PersonID": 1},
+			},
+			"Order": {
+				{"ID": 100, "PersonID": 1, "Total": 12.5},
+				{"ID": 101, "PersonID": 1, "Total": 30.0},
+			},
+		},
+	}
+}
+
+func (suite *ProjectorTestSuite) TearDownTest() {
+	suite.Registry = nil
+	suite.Source = testDataSource{}
+}
+
+func (suite *ProjectorTestSuite) TestProjectByID() {
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	entityRecord, projectErr := projector.ProjectByID("Person", 1)
+
+	suite.Require().NoError(projectErr)
+	suite.Equal(map[string]any{
+		"ID":           1,
+		"FirstName":    "Ada",
+		"FullName":     "Ada Lovelace",
+		"Nationality":  "German",
+		"SSN":          "sealed",
+		"TaxID":        "sealed",
+		"EmployerName": "Analytical Engines",
+		"MentorName":   nil,
+		"Email":        "ada@example.com",
+		"OrderCount":   int64(2),
+		"OrderTotal":   42.5,
+		"MaxOrder":     30.0,
+	}, entityRecord)
+}
+
+func (suite *ProjectorTestSuite) TestProject_AliasedAndMissingTargets() {
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	entityRecord, projectErr := projector.Project("Person", suite.Source.records["Person"][1])
+
+	suite.Require().NoError(projectErr)
+	suite.Equal("Ada", entityRecord["MentorName"])
+	suite.Nil(entityRecord["EmployerName"])
+	suite.Nil(entityRecord["Email"])
+	suite.Equal(int64(0), entityRecord["OrderCount"])
+	suite.Equal(0.0, entityRecord["OrderTotal"])
+	suite.Nil(entityRecord["MaxOrder"])
+}
+
+func (suite *ProjectorTestSuite) TestProject_HasManyThroughInverseForeignKey() {
+	suite.Source.records["Company"][0]["OwnerID"] = 2
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	entityRecord, projectErr := projector.ProjectByID("Company", 10)
+
+	suite.Require().NoError(projectErr)
+	suite.Equal("EC1 London", entityRecord["Location"])
+	suite.Equal("Alan", entityRecord["OwnerName"])
+	suite.Equal(int64(1), entityRecord["EmployeeCount"])
+}
+
+func (suite *ProjectorTestSuite) TestProjectByID_RecordNotFound() {
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	_, projectErr := projector.ProjectByID("Person", 3)
+
+	suite.ErrorContains(projectErr, "model 'Person' record with identifier '3' not found")
+}
+
+func (suite *ProjectorTestSuite) TestProject_UnknownEntity() {
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	_, projectErr := projector.Project("Unknown", map[string]any{})
+
+	suite.ErrorContains(projectErr, "entity with name 'Unknown' not found in registry")
+}
+
+func (suite *ProjectorTestSuite) TestProject_MixedRootModels() {
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	_, projectErr := projector.ProjectByID("Order", 100)
+
+	suite.ErrorContains(projectErr, "entity 'Order' field 'OwnerName' starts at model 'Person', but the entity is projected from model 'Order'")
+}
+
+func (suite *ProjectorTestSuite) TestProject_DataSourceError() {
+	delete(suite.Source.records, "Company")
+	projector := projection.NewProjector(suite.Registry, suite.Source)
+
+	_, projectErr := projector.ProjectByID("Person", 1)
+
+	suite.ErrorContains(projectErr, "error projecting entity 'Person' field 'EmployerName': no 'Company' records")
+}
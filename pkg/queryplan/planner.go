@@ -0,0 +1,164 @@
+package queryplan
+
+import (
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+)
+
+// planner accumulates the joins of a plan, sharing joins between fields with a common path prefix
+type planner struct {
+	models     map[string]yaml.Model
+	enums      map[string]yaml.Enum
+	structures map[string]yaml.Structure
+
+	plan        QueryPlan
+	joinAliases map[string]bool
+}
+
+// PlanEntity plans the joins and selections to read an entity from its root model
+func PlanEntity(r *registry.Registry, entityName string) (QueryPlan, error) {
+	entity, entityErr := r.GetEntity(entityName)
+	if entityErr != nil {
+		return QueryPlan{}, ErrUnknownEntity(entityName)
+	}
+
+	p := planner{
+		models:      r.GetAllModels(),
+		enums:       r.GetAllEnums(),
+		structures:  r.GetAllStructures(),
+		joinAliases: map[string]bool{},
+	}
+	rootModelName, rootErr := entity.ResolveRootModelName(p.models, p.enums, p.structures)
+	if rootErr != nil {
+		return QueryPlan{}, rootErr
+	}
+	p.plan = QueryPlan{
+		Entity:     entityName,
+		RootModel:  rootModelName,
+		RootAlias:  getTableAlias(rootModelName),
+		Joins:      []Join{},
+		Selections: []Selection{},
+	}
+
+	for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+		resolved, resolveErr := entity.ResolveFieldExpression(fieldName, p.models, p.enums, p.structures)
+		if resolveErr != nil {
+			return QueryPlan{}, resolveErr
+		}
+		selection, selectionErr := p.planSelection(entity, fieldName, resolved)
+		if selectionErr != nil {
+			return QueryPlan{}, selectionErr
+		}
+		p.plan.Selections = append(p.plan.Selections, selection)
+	}
+	return p.plan, nil
+}
+
+func (p *planner) planSelection(entity yaml.Entity, fieldName string, resolved yaml.ResolvedEntityFieldExpression) (Selection, error) {
+	selection := Selection{
+		Alias:     fieldName,
+		Function:  resolved.Expression.Function,
+		Arguments: []SelectionArgument{},
+		Type:      resolved.Type,
+	}
+
+	pathIdx := 0
+	for _, argument := range resolved.Expression.Arguments {
+		if argument.IsLiteral {
+			selection.Arguments = append(selection.Arguments, SelectionArgument{Literal: argument.Literal, IsLiteral: true})
+			continue
+		}
+
+		resolvedPath := resolved.Paths[pathIdx]
+		pathIdx++
+		if resolvedPath.RootModel().Name != p.plan.RootModel {
+			return Selection{}, ErrMixedRootModels(entity.Name, fieldName, resolvedPath.RootModel().Name, p.plan.RootModel)
+		}
+
+		tableAlias, joinErr := p.planJoins(resolvedPath.Hops)
+		if joinErr != nil {
+			return Selection{}, joinErr
+		}
+		selection.Arguments = append(selection.Arguments, SelectionArgument{
+			TableAlias: tableAlias,
+			Model:      resolvedPath.TerminalModel().Name,
+			Field:      resolvedPath.TerminalFieldName,
+		})
+	}
+	return selection, nil
+}
+
+// planJoins adds the joins of the hops that are not planned yet, and returns the alias of the last hop
+func (p *planner) planJoins(hops []yaml.ModelFieldPathHop) (string, error) {
+	aliasSegments := []string{p.plan.RootAlias}
+	fromAlias := p.plan.RootAlias
+	for _, hop := range hops {
+		aliasSegments = append(aliasSegments, hop.RelationName)
+		alias := getTableAlias(aliasSegments...)
+		if !p.joinAliases[alias] {
+			join, joinErr := p.newJoin(alias, fromAlias, hop)
+			if joinErr != nil {
+				return "", joinErr
+			}
+			p.plan.Joins = append(p.plan.Joins, join)
+			p.joinAliases[alias] = true
+		}
+		fromAlias = alias
+	}
+	return fromAlias, nil
+}
+
+func (p *planner) newJoin(alias string, fromAlias string, hop yaml.ModelFieldPathHop) (Join, error) {
+	join := Join{
+		Alias:        alias,
+		FromAlias:    fromAlias,
+		FromModel:    hop.FromModel,
+		RelationName: hop.RelationName,
+		RelationType: hop.Relation.Type,
+		ToModel:      hop.ToModel,
+		Cardinality:  hop.Cardinality,
+	}
+
+	if yamlops.IsRelationFor(hop.Relation.Type) {
+		referencedKey, keyErr := p.getPrimaryKey(hop.ToModel)
+		if keyErr != nil {
+			return Join{}, keyErr
+		}
+		join.ForeignKeySide = ForeignKeySideFrom
+		join.ForeignKey = yamlops.GetForRelationForeignKey(hop.RelationName, hop.Relation)
+		join.ReferencedKey = referencedKey
+		join.Optional = !hop.Relation.Required
+		return join, nil
+	}
+
+	referencedKey, keyErr := p.getPrimaryKey(hop.FromModel)
+	if keyErr != nil {
+		return Join{}, keyErr
+	}
+	foreignKey, foreignKeyErr := yamlops.GetHasRelationForeignKey(hop.FromModel, hop.Relation, p.models[hop.ToModel])
+	if foreignKeyErr != nil {
+		return Join{}, foreignKeyErr
+	}
+	join.ForeignKeySide = ForeignKeySideTo
+	join.ForeignKey = foreignKey
+	join.ReferencedKey = referencedKey
+	join.Optional = true
+	return join, nil
+}
+
+// getTableAlias joins alias segments with underscores, the namespaces of qualified names are joined the same way
+func getTableAlias(segments ...string) string {
+	return strings.ReplaceAll(strings.Join(segments, "_"), yaml.NamespaceSeparator, "_")
+}
+
+func (p *planner) getPrimaryKey(modelName string) (string, error) {
+	primary, exists := p.models[modelName].Identifiers["primary"]
+	if !exists || len(primary.Fields) != 1 {
+		return "", ErrNoSingleReferencedKey(modelName)
+	}
+	return primary.Fields[0], nil
+}
@@ -0,0 +1,147 @@
+package queryplan_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/queryplan"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+type PlannerTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+}
+
+func TestPlannerTestSuite(t *testing.T) {
+	suite.Run(t, new(PlannerTestSuite))
+}
+
+func (suite *PlannerTestSuite) SetupTest() {
+	suite.Registry = suite.loadRegistry("records")
+}
+
+func (suite *PlannerTestSuite) TearDownTest() {
+	suite.Registry = nil
+}
+
+func (suite *PlannerTestSuite) loadRegistry(registryName string) *registry.Registry {
+	config := testutils.GetRegistryConfig(registryName)
+	config.DirectoryNamespaces = true
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, config)
+	suite.Require().NoError(loadErr)
+	return r
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity() {
+	plan, planErr := queryplan.PlanEntity(suite.Registry, "Company")
+
+	suite.Require().NoError(planErr)
+	suite.Equal("Company", plan.RootModel)
+	suite.Equal("Company", plan.RootAlias)
+	suite.Equal([]queryplan.Join{
+		{
+			Alias: "Company_Employee", FromAlias: "Company", FromModel: "Company", RelationName: "Employee", RelationType: "HasMany", ToModel: "Person",
+			Cardinality: yaml.RelationCardinalityMany, ForeignKeySide: queryplan.ForeignKeySideTo, ForeignKey: "EmployerID", ReferencedKey: "ID", Optional: true,
+		},
+		{
+			Alias: "Company_Office", FromAlias: "Company", FromModel: "Company", RelationName: "Office", RelationType: "HasOne", ToModel: "Office",
+			Cardinality: yaml.RelationCardinalityOne, ForeignKeySide: queryplan.ForeignKeySideTo, ForeignKey: "CompanyID", ReferencedKey: "ID", Optional: true,
+		},
+		{
+			Alias: "Company_Owner", FromAlias: "Company", FromModel: "Company", RelationName: "Owner", RelationType: "ForOne", ToModel: "Person",
+			Cardinality: yaml.RelationCardinalityOne, ForeignKeySide: queryplan.ForeignKeySideFrom, ForeignKey: "OwnerID", ReferencedKey: "ID", Optional: true,
+		},
+	}, plan.Joins)
+	suite.Equal([]queryplan.Selection{
+		{Alias: "EmployeeCount", Function: yaml.EntityFieldFunctionCount, Type: yaml.ModelFieldTypeInteger, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company_Employee", Model: "Person"},
+		}},
+		{Alias: "ID", Type: yaml.ModelFieldTypeAutoIncrement, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company", Model: "Company", Field: "ID"},
+		}},
+		{Alias: "Location", Function: yaml.EntityFieldFunctionConcat, Type: yaml.ModelFieldTypeString, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company_Office", Model: "Office", Field: "ZipCode"},
+			{Literal: " ", IsLiteral: true},
+			{TableAlias: "Company_Office", Model: "Office", Field: "City"},
+		}},
+		{Alias: "Name", Type: yaml.ModelFieldTypeString, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company", Model: "Company", Field: "Name"},
+		}},
+		{Alias: "OwnerName", Type: yaml.ModelFieldTypeString, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company_Owner", Model: "Person", Field: "FirstName"},
+		}},
+		{Alias: "TotalSalary", Function: yaml.EntityFieldFunctionSum, Type: yaml.ModelFieldTypeFloat, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company_Employee", Model: "Person", Field: "Salary"},
+		}},
+		{Alias: "ZipCode", Type: yaml.ModelFieldTypeString, Arguments: []queryplan.SelectionArgument{
+			{TableAlias: "Company_Office", Model: "Office", Field: "ZipCode"},
+		}},
+	}, plan.Selections)
+	suite.True(plan.IsAggregated())
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity_Verbose() {
+	r := suite.loadRegistry("verbose")
+
+	plan, planErr := queryplan.PlanEntity(r, "Person")
+
+	suite.Require().NoError(planErr)
+	suite.False(plan.IsAggregated())
+	suite.Equal([]queryplan.Join{
+		{
+			Alias: "Person_ContactInfo", FromAlias: "Person", FromModel: "Person", RelationName: "ContactInfo", RelationType: "HasOne", ToModel: "ContactInfo",
+			Cardinality: yaml.RelationCardinalityOne, ForeignKeySide: queryplan.ForeignKeySideTo, ForeignKey: "PersonID", ReferencedKey: "ID", Optional: true,
+		},
+	}, plan.Joins)
+
+	planJSON, jsonErr := plan.ToJSON()
+	suite.Require().NoError(jsonErr)
+	var decoded queryplan.QueryPlan
+	suite.Require().NoError(json.Unmarshal(planJSON, &decoded))
+	suite.Equal(plan, decoded)
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity_UnresolvableField() {
+	r := suite.loadRegistry("verbose")
+
+	_, planErr := queryplan.PlanEntity(r, "Company")
+
+	suite.ErrorContains(planErr, "morphe entity Company field City references unknown terminal field: City in path Company.Address.City")
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity_MixedRootModels() {
+	_, planErr := queryplan.PlanEntity(suite.Registry, "Order")
+
+	suite.ErrorContains(planErr, "entity 'Order' field 'OwnerName' starts at model 'Person', but the entity is planned from model 'Order'")
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity_UnknownEntity() {
+	_, planErr := queryplan.PlanEntity(suite.Registry, "Unknown")
+
+	suite.ErrorContains(planErr, "entity with name 'Unknown' not found in registry")
+}
+
+func (suite *PlannerTestSuite) TestPlanEntity_Namespaced() {
+	r := suite.loadRegistry("namespaces")
+
+	plan, planErr := queryplan.PlanEntity(r, "billing.Invoice")
+
+	suite.Require().NoError(planErr)
+	suite.Equal("billing.Invoice", plan.RootModel)
+	suite.Equal("billing_Invoice", plan.RootAlias)
+	suite.Equal([]queryplan.Join{
+		{
+			Alias: "billing_Invoice_Person", FromAlias: "billing_Invoice", FromModel: "billing.Invoice", RelationName: "Person", RelationType: "ForOne", ToModel: "Person",
+			Cardinality: yaml.RelationCardinalityOne, ForeignKeySide: queryplan.ForeignKeySideFrom, ForeignKey: "PersonID", ReferencedKey: "ID", Optional: true,
+		},
+	}, plan.Joins)
+	suite.Contains(plan.Selections, queryplan.Selection{Alias: "PersonName", Type: yaml.ModelFieldTypeString, Arguments: []queryplan.SelectionArgument{
+		{TableAlias: "billing_Invoice_Person", Model: "Person", Field: "Name"},
+	}})
+}
@@ -0,0 +1,84 @@
+package queryplan
+
+import (
+	"encoding/json"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// ForeignKeySide is the side of a join that holds the foreign key column
+type ForeignKeySide string
+
+const (
+	// ForeignKeySideFrom means the joined-from model holds the foreign key, as for 'For' relations
+	ForeignKeySideFrom ForeignKeySide = "from"
+
+	// ForeignKeySideTo means the joined model holds the foreign key, as for 'Has' relations
+	ForeignKeySideTo ForeignKeySide = "to"
+)
+
+// QueryPlan is an abstract, backend independent plan for reading an entity from its models
+type QueryPlan struct {
+	Entity    string `json:"entity"`
+	RootModel string `json:"rootModel"`
+	RootAlias string `json:"rootAlias"`
+
+	// Joins are ordered so that every join only references the root or earlier joins
+	Joins      []Join      `json:"joins"`
+	Selections []Selection `json:"selections"`
+}
+
+// Join walks a single model relation from an already available alias
+type Join struct {
+	Alias        string                   `json:"alias"`
+	FromAlias    string                   `json:"fromAlias"`
+	FromModel    string                   `json:"fromModel"`
+	RelationName string                   `json:"relationName"`
+	RelationType string                   `json:"relationType"`
+	ToModel      string                   `json:"toModel"`
+	Cardinality  yaml.RelationCardinality `json:"cardinality"`
+
+	// ForeignKey is the column on the ForeignKeySide that references the ReferencedKey on the other side
+	ForeignKeySide ForeignKeySide `json:"foreignKeySide"`
+	ForeignKey     string         `json:"foreignKey"`
+	ReferencedKey  string         `json:"referencedKey"`
+
+	// Optional joins may have no matching record and must not filter out the root record
+	Optional bool `json:"optional"`
+}
+
+// Selection is a selected entity field, computed from the columns of its arguments
+type Selection struct {
+	// Alias is the entity field name
+	Alias string `json:"alias"`
+
+	// Function is empty for plain columns
+	Function  yaml.EntityFieldFunction `json:"function,omitempty"`
+	Arguments []SelectionArgument      `json:"arguments"`
+	Type      yaml.ModelFieldType      `json:"type"`
+}
+
+// SelectionArgument is a column of a root or join alias, or a string literal.
+// Count arguments reference the join alias without a field.
+type SelectionArgument struct {
+	TableAlias string `json:"tableAlias,omitempty"`
+	Model      string `json:"model,omitempty"`
+	Field      string `json:"field,omitempty"`
+	Literal    string `json:"literal,omitempty"`
+	IsLiteral  bool   `json:"isLiteral,omitempty"`
+}
+
+// IsAggregated returns true if any selection aggregates the records of a to-many join
+func (p QueryPlan) IsAggregated() bool {
+	for _, selection := range p.Selections {
+		if yaml.IsEntityFieldFunctionAggregate(selection.Function) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToJSON serialises the plan as indented JSON
+func (p QueryPlan) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
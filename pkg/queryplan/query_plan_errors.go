@@ -0,0 +1,15 @@
+package queryplan
+
+import "fmt"
+
+func ErrUnknownEntity(entityName string) error {
+	return fmt.Errorf("entity with name '%s' not found in registry", entityName)
+}
+
+func ErrMixedRootModels(entityName string, fieldName string, rootModelName string, entityRootModelName string) error {
+	return fmt.Errorf("entity '%s' field '%s' starts at model '%s', but the entity is planned from model '%s'", entityName, fieldName, rootModelName, entityRootModelName)
+}
+
+func ErrNoSingleReferencedKey(modelName string) error {
+	return fmt.Errorf("model '%s' cannot be joined: its primary identifier must have exactly 1 field", modelName)
+}
@@ -1,10 +1,21 @@
 package cfg
 
+import "github.com/kalo-build/morphe-go/pkg/yamlfile"
+
 type MorpheLoadRegistryConfig struct {
 	RegistryEnumsDirPath      string
 	RegistryModelsDirPath     string
 	RegistryStructuresDirPath string
 	RegistryEntitiesDirPath   string
+
+	// RegistryMixinsDirPath is optional, models can only include mixins if it is set
+	RegistryMixinsDirPath string
+
+	// SpecVersion is the project wide spec version of files without a 'morphe' key, defaults to the current version
+	SpecVersion yamlfile.SpecVersion
+
+	// DirectoryNamespaces loads nested directories of the registry directories, each nested directory such as 'billing' declares a namespace
+	DirectoryNamespaces bool
 }
 
 func (config MorpheLoadRegistryConfig) Validate() error {
@@ -20,5 +31,8 @@ func (config MorpheLoadRegistryConfig) Validate() error {
 	if config.RegistryEntitiesDirPath == "" {
 		return ErrNoRegistryEntitiesDirPath
 	}
+	if config.SpecVersion != "" && !config.SpecVersion.IsSupported() {
+		return yamlfile.ErrUnsupportedSpecVersion(config.SpecVersion)
+	}
 	return nil
 }
@@ -32,21 +32,38 @@ func LoadMorpheRegistry(hooks LoadMorpheRegistryHooks, config cfg.MorpheLoadRegi
 }
 
 func loadConfiguredRegistry(config cfg.MorpheLoadRegistryConfig, r *Registry) error {
+	if config.SpecVersion != "" {
+		versionErr := r.SetDefaultSpecVersion(config.SpecVersion)
+		if versionErr != nil {
+			return versionErr
+		}
+	}
+
+	r.SetDirectoryNamespaces(config.DirectoryNamespaces)
+
 	enumsErr := r.LoadEnumsFromDirectory(config.RegistryEnumsDirPath)
 	if enumsErr != nil {
 		return enumsErr
 	}
 
-	modelsErr := r.LoadModelsFromDirectory(config.RegistryModelsDirPath)
-	if modelsErr != nil {
-		return modelsErr
-	}
-
+	// Structures are loaded before models so namespaced models can reference the structures of their namespace
 	structuresErr := r.LoadStructuresFromDirectory(config.RegistryStructuresDirPath)
 	if structuresErr != nil {
 		return structuresErr
 	}
 
+	if config.RegistryMixinsDirPath != "" {
+		mixinsErr := r.LoadMixinsFromDirectory(config.RegistryMixinsDirPath)
+		if mixinsErr != nil {
+			return mixinsErr
+		}
+	}
+
+	modelsErr := r.LoadModelsFromDirectory(config.RegistryModelsDirPath)
+	if modelsErr != nil {
+		return modelsErr
+	}
+
 	entitiesErr := r.LoadEntitiesFromDirectory(config.RegistryEntitiesDirPath)
 	if entitiesErr != nil {
 		return entitiesErr
@@ -1,5 +1,16 @@
 package registry
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var ErrRegistryNotInitialized = errors.New("morphe registry has not been initialized")
+
+func ErrInvalidNamespace(filePath string, namespace string) error {
+	return fmt.Errorf("namespace '%s' must consist of dot separated lowercase segments (file: %s)", namespace, filePath)
+}
+
+func ErrConflictingNamespace(filePath string, namespace string, dirNamespace string) error {
+	return fmt.Errorf("namespace '%s' conflicts with the directory namespace '%s' (file: %s)", namespace, dirNamespace, filePath)
+}
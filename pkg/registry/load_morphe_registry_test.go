@@ -2,11 +2,13 @@ package registry_test
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/kalo-build/go-util/core"
 	"github.com/kalo-build/morphe-go/internal/testutils"
 	"github.com/kalo-build/morphe-go/pkg/registry"
 	"github.com/kalo-build/morphe-go/pkg/registry/cfg"
@@ -558,3 +560,192 @@ func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_SuccessHook_Fai
 	suite.ErrorContains(registryErr, "compile model success hook error")
 	suite.Nil(r)
 }
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_UnsupportedSpecVersion() {
+	config := cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      suite.EnumsDirPath,
+		RegistryModelsDirPath:     suite.ModelsDirPath,
+		RegistryStructuresDirPath: suite.StructuresDirPath,
+		RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		SpecVersion:               "0",
+	}
+
+	_, registryErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, config)
+
+	suite.ErrorContains(registryErr, "unsupported morphe spec version '0'")
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_Mixins() {
+	mixinsDirPath := filepath.Join(suite.TestDirPath, "registry", "mixins")
+	config := cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(mixinsDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(mixinsDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(mixinsDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(mixinsDirPath, "entities"),
+		RegistryMixinsDirPath:     filepath.Join(mixinsDirPath, "mixins"),
+	}
+
+	r, registryErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, config)
+
+	suite.NoError(registryErr)
+	suite.Len(r.GetAllMixins(), 3)
+
+	person, personErr := r.GetModel("Person")
+	suite.Nil(personErr)
+	suite.Nil(person.Includes)
+	suite.Equal([]string{"CreatedAt", "FirstName", "ID", "LastName", "UUID", "UpdatedAt"}, core.MapKeysSorted(person.Fields))
+	suite.Equal([]string{"entity", "name", "primary"}, core.MapKeysSorted(person.Identifiers))
+	suite.Equal(yaml.ModelFieldTypeUUID, person.Fields["UUID"].Type)
+	suite.Equal([]string{"immutable", "mandatory"}, person.Fields["UUID"].Attributes)
+	suite.Equal([]string{"Keys", "Timestamps", "Record"}, r.GetModelMixins("Person"))
+
+	company, companyErr := r.GetModel("Company")
+	suite.Nil(companyErr)
+	suite.Equal([]string{"ID", "Name", "UUID"}, core.MapKeysSorted(company.Fields))
+
+	affected := r.GetAffectedDefinitions([]registry.DefinitionRef{{Kind: registry.DefinitionKindMixin, Name: "Timestamps"}})
+	suite.Equal([]registry.DefinitionRef{
+		{Kind: registry.DefinitionKindModel, Name: "Company"},
+		{Kind: registry.DefinitionKindModel, Name: "Person"},
+		{Kind: registry.DefinitionKindEntity, Name: "Person"},
+		{Kind: registry.DefinitionKindMixin, Name: "Record"},
+		{Kind: registry.DefinitionKindMixin, Name: "Timestamps"},
+	}, affected)
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_MixinConflict() {
+	r := registry.NewRegistry()
+	r.SetMixin("Record", yaml.Mixin{Name: "Record", Fields: map[string]yaml.ModelField{"FirstName": {Type: yaml.ModelFieldTypeString}}})
+	r.SetMixin("Keys", yaml.Mixin{Name: "Keys", Fields: map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}}})
+
+	loadErr := r.LoadModelsFromDirectory(filepath.Join(suite.TestDirPath, "registry", "mixins", "models"))
+
+	suite.ErrorContains(loadErr, "morphe model 'Person' field 'FirstName' from mixin 'Record' conflicts with the model field")
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_EntityIncludes() {
+	mixinsDirPath := filepath.Join(suite.TestDirPath, "registry", "mixins")
+	config := cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(mixinsDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(mixinsDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(mixinsDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(mixinsDirPath, "entities"),
+		RegistryMixinsDirPath:     filepath.Join(mixinsDirPath, "mixins"),
+	}
+
+	r, registryErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, config)
+
+	suite.NoError(registryErr)
+	person, personErr := r.GetEntity("Person")
+	suite.Nil(personErr)
+	suite.Equal(yaml.EntityIncludes{{
+		From:    "Person.*",
+		Exclude: []string{"UpdatedAt"},
+		Rename:  map[string]string{"CreatedAt": "JoinedAt"},
+	}}, person.Includes)
+	suite.Equal([]string{"FirstName", "ID", "JoinedAt", "LastName", "UUID"}, core.MapKeysSorted(person.Fields))
+	suite.Equal(yaml.ModelFieldPath("Person.CreatedAt"), person.Fields["JoinedAt"].Type)
+	suite.Equal([]string{"mandatory"}, person.Fields["ID"].Attributes)
+	suite.Equal([]string{"immutable", "mandatory", "searchable"}, person.Fields["UUID"].Attributes)
+
+	resolved, resolveErr := r.ResolveEntityFieldPath("Person", "JoinedAt")
+	suite.NoError(resolveErr)
+	suite.Equal(yaml.ModelFieldTypeTime, resolved.Primitive)
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_Namespaces() {
+	namespacesDirPath := filepath.Join(suite.TestDirPath, "registry", "namespaces")
+	config := cfg.MorpheLoadRegistryConfig{
+		RegistryEnumsDirPath:      filepath.Join(namespacesDirPath, "enums"),
+		RegistryModelsDirPath:     filepath.Join(namespacesDirPath, "models"),
+		RegistryStructuresDirPath: filepath.Join(namespacesDirPath, "structures"),
+		RegistryEntitiesDirPath:   filepath.Join(namespacesDirPath, "entities"),
+		RegistryMixinsDirPath:     filepath.Join(namespacesDirPath, "mixins"),
+		DirectoryNamespaces:       true,
+	}
+
+	r, registryErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, config)
+
+	suite.NoError(registryErr)
+	suite.Equal([]string{"Person", "audit.Entry", "billing.Invoice"}, core.MapKeysSorted(r.GetAllModels()))
+	suite.Equal([]string{"audit", "billing"}, r.GetNamespaces())
+
+	money, moneyErr := r.GetStructure("billing.Money")
+	suite.Nil(moneyErr)
+	suite.Equal(yaml.StructureFieldType("billing.Currency"), money.Fields["Currency"].Type)
+
+	invoice, invoiceErr := r.GetModelInNamespace("billing", "Invoice")
+	suite.Nil(invoiceErr)
+	suite.Equal("billing.Invoice", invoice.Name)
+	suite.Equal("billing", invoice.Namespace)
+	suite.Equal(yaml.ModelFieldType("billing.Money"), invoice.Fields["Total"].Type)
+	suite.Equal(yaml.ModelFieldType("billing.Currency"), invoice.Fields["Currency"].Type)
+	suite.Equal("", invoice.Related["Person"].Aliased)
+	suite.Equal(yaml.ModelFieldType("billing.Currency"), invoice.Fields["LedgerCurrency"].Type)
+	suite.Equal([]string{"Currency", "ID", "LedgerCurrency", "Total"}, core.MapKeysSorted(invoice.Fields))
+	suite.Equal([]string{"Record", "billing.Ledger"}, r.GetModelMixins("billing.Invoice"))
+	suite.Equal([]string{"Record", "billing.Ledger"}, core.MapKeysSorted(r.GetAllMixins()))
+
+	person, personErr := r.GetModelInNamespace("billing", "Person")
+	suite.Nil(personErr)
+	suite.Equal("Person", person.Name)
+	suite.Equal([]string{"Invoice"}, core.MapKeysSorted(person.Related))
+	suite.Equal("billing.Invoice", person.Related["Invoice"].Aliased)
+
+	entry, entryErr := r.GetModel("audit.Entry")
+	suite.Nil(entryErr)
+	suite.Equal("Person", entry.Related["Author"].Aliased)
+
+	invoiceEntity, invoiceEntityErr := r.GetEntityInNamespace("billing", "Invoice")
+	suite.Nil(invoiceEntityErr)
+	suite.Equal(yaml.ModelFieldPath("billing.Invoice.Person.Name"), invoiceEntity.Fields["PersonName"].Type)
+
+	resolved, resolveErr := r.ResolveEntityFieldPath("billing.Invoice", "Currency")
+	suite.NoError(resolveErr)
+	suite.Require().NotNil(resolved.Enum)
+	suite.Equal("billing.Currency", resolved.Enum.Name)
+
+	personEntity, personEntityErr := r.GetEntity("Person")
+	suite.Nil(personEntityErr)
+	suite.Equal("billing.Invoice", personEntity.Related["Invoice"].Aliased)
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_ConflictingNamespace() {
+	modelsDirPath := suite.T().TempDir()
+	nestedDirPath := filepath.Join(modelsDirPath, "billing")
+	suite.Require().NoError(os.Mkdir(nestedDirPath, 0o755))
+	modelYAML := "namespace: sales\nname: Invoice\nfields:\n  ID:\n    type: AutoIncrement\nidentifiers:\n  primary: ID\n"
+	suite.Require().NoError(os.WriteFile(filepath.Join(nestedDirPath, "invoice.mod"), []byte(modelYAML), 0o644))
+
+	r := registry.NewRegistry()
+	r.SetDirectoryNamespaces(true)
+
+	loadErr := r.LoadModelsFromDirectory(modelsDirPath)
+
+	suite.ErrorContains(loadErr, "namespace 'sales' conflicts with the directory namespace 'billing'")
+}
+
+func (suite *LoadMorpheRegistryTestSuite) TestLoadMorpheRegistry_DirectoryNamespacesOptIn() {
+	modelsDirPath := suite.T().TempDir()
+	modelYAML := "name: Person\nfields:\n  ID:\n    type: AutoIncrement\nidentifiers:\n  primary: ID\n"
+	for _, dirPath := range []string{modelsDirPath, filepath.Join(modelsDirPath, "Legacy"), filepath.Join(modelsDirPath, ".archive")} {
+		suite.Require().NoError(os.MkdirAll(dirPath, 0o755))
+		suite.Require().NoError(os.WriteFile(filepath.Join(dirPath, "person.mod"), []byte(modelYAML), 0o644))
+	}
+
+	flat := registry.NewRegistry()
+	flatErr := flat.LoadModelsFromDirectory(modelsDirPath)
+	namespaced := registry.NewRegistry()
+	namespaced.SetDirectoryNamespaces(true)
+	namespacedErr := namespaced.LoadModelsFromDirectory(modelsDirPath)
+
+	suite.Require().NoError(flatErr)
+	suite.Equal([]string{"Person"}, core.MapKeysSorted(flat.GetAllModels()))
+	suite.ErrorContains(namespacedErr, "namespace 'Legacy' must consist of dot separated lowercase segments")
+
+	suite.Require().NoError(os.RemoveAll(filepath.Join(modelsDirPath, "Legacy")))
+	hidden := registry.NewRegistry()
+	hidden.SetDirectoryNamespaces(true)
+	suite.Require().NoError(hidden.LoadModelsFromDirectory(modelsDirPath))
+	suite.Equal([]string{"Person"}, core.MapKeysSorted(hidden.GetAllModels()))
+}
@@ -0,0 +1,346 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// DateFormat is the expected layout of Date field string values
+const DateFormat = "2006-01-02"
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// recordField is the type and attributes of a field, regardless of the definition kind it belongs to
+type recordField struct {
+	Type       string
+	Attributes []string
+}
+
+// ValidateRecord validates a record against the model, entity or structure with the specified name.
+// A name shared by several kinds is ambiguous, use ValidateModelRecord, ValidateEntityRecord or ValidateStructureRecord instead.
+// It returns a *RecordValidationError listing every invalid field.
+func (r *Registry) ValidateRecord(name string, data map[string]any) error {
+	kind, kindErr := r.GetRecordDefinitionKind(name)
+	if kindErr != nil {
+		return kindErr
+	}
+
+	switch kind {
+	case DefinitionKindModel:
+		return r.ValidateModelRecord(name, data)
+	case DefinitionKindEntity:
+		return r.ValidateEntityRecord(name, data)
+	}
+	return r.ValidateStructureRecord(name, data)
+}
+
+// GetRecordDefinitionKind returns whether the name is a model, entity or structure, a name shared by several kinds is ambiguous
+func (r *Registry) GetRecordDefinitionKind(name string) (DefinitionKind, error) {
+	r.mutex.RLock()
+	_, isModel := r.models[name]
+	_, isEntity := r.entities[name]
+	_, isStructure := r.structures[name]
+	r.mutex.RUnlock()
+
+	kinds := []DefinitionKind{}
+	if isModel {
+		kinds = append(kinds, DefinitionKindModel)
+	}
+	if isEntity {
+		kinds = append(kinds, DefinitionKindEntity)
+	}
+	if isStructure {
+		kinds = append(kinds, DefinitionKindStructure)
+	}
+
+	switch len(kinds) {
+	case 0:
+		return "", ErrUnknownRecordDefinition(name)
+	case 1:
+		return kinds[0], nil
+	}
+	return "", ErrAmbiguousRecordDefinition(name, kinds)
+}
+
+// ValidateRecordJSON decodes a JSON object and validates it like ValidateRecord
+func (r *Registry) ValidateRecordJSON(name string, jsonData []byte) error {
+	data, decodeErr := DecodeRecordJSON(name, jsonData)
+	if decodeErr != nil {
+		return decodeErr
+	}
+	return r.ValidateRecord(name, data)
+}
+
+// DecodeRecordJSON decodes a JSON object into a record for the validators, keeping numbers exact
+func DecodeRecordJSON(name string, jsonData []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var data map[string]any
+	decodeErr := decoder.Decode(&data)
+	if decodeErr != nil {
+		return nil, ErrInvalidRecordJSON(name, decodeErr)
+	}
+	return data, nil
+}
+
+// ValidateModelRecord validates a record against a registry model
+func (r *Registry) ValidateModelRecord(modelName string, data map[string]any) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	model, exists := r.models[modelName]
+	if !exists {
+		return ErrUnknownRecordDefinition(modelName)
+	}
+
+	fields := make(map[string]recordField, len(model.Fields))
+	for fieldName, field := range model.Fields {
+		fields[fieldName] = recordField{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return r.validateRecord(modelName, fields, data)
+}
+
+// ValidateEntityRecord validates a record against a registry entity, using the resolved type of each field path
+func (r *Registry) ValidateEntityRecord(entityName string, data map[string]any) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entity, exists := r.entities[entityName]
+	if !exists {
+		return ErrUnknownRecordDefinition(entityName)
+	}
+
+	fields := make(map[string]recordField, len(entity.Fields))
+	for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+		resolved, resolveErr := entity.ResolveFieldExpression(fieldName, r.models, r.enums, r.structures)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		fields[fieldName] = recordField{Type: string(resolved.Type), Attributes: entity.Fields[fieldName].Attributes}
+	}
+	return r.validateRecord(entityName, fields, data)
+}
+
+// ValidateStructureRecord validates a record against a registry structure
+func (r *Registry) ValidateStructureRecord(structureName string, data map[string]any) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, exists := r.structures[structureName]; !exists {
+		return ErrUnknownRecordDefinition(structureName)
+	}
+	return r.validateRecord(structureName, r.getStructureRecordFields(structureName), data)
+}
+
+// validateRecord collects the field errors of a record, the caller must hold the read lock
+func (r *Registry) validateRecord(definitionName string, fields map[string]recordField, data map[string]any) error {
+	fieldErrors := r.validateRecordFields("", fields, data)
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	sort.SliceStable(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+	return &RecordValidationError{
+		Definition: definitionName,
+		Errors:     fieldErrors,
+	}
+}
+
+func (r *Registry) validateRecordFields(pathPrefix string, fields map[string]recordField, data map[string]any) []RecordFieldError {
+	fieldErrors := []RecordFieldError{}
+	for _, fieldName := range core.MapKeysSorted(data) {
+		if _, exists := fields[fieldName]; !exists {
+			fieldErrors = append(fieldErrors, RecordFieldError{Field: pathPrefix + fieldName, Message: "is not a known field"})
+		}
+	}
+
+	for _, fieldName := range core.MapKeysSorted(fields) {
+		field := fields[fieldName]
+		fieldPath := pathPrefix + fieldName
+		value, isSet := data[fieldName]
+		if !isSet || value == nil {
+			if isRecordFieldMandatory(field) {
+				fieldErrors = append(fieldErrors, RecordFieldError{Field: fieldPath, Message: "is mandatory"})
+			}
+			continue
+		}
+		fieldErrors = append(fieldErrors, r.validateRecordValue(fieldPath, field.Type, value)...)
+	}
+	return fieldErrors
+}
+
+// isRecordFieldMandatory checks the mandatory attribute, AutoIncrement values are generated by storage and may be absent
+func isRecordFieldMandatory(field recordField) bool {
+	return slices.Contains(field.Attributes, "mandatory") && field.Type != string(yaml.ModelFieldTypeAutoIncrement)
+}
+
+func (r *Registry) validateRecordValue(fieldPath string, typeName string, value any) []RecordFieldError {
+	if enum, isEnum := r.enums[typeName]; isEnum {
+		if !isRecordEnumValue(enum, value) {
+			return []RecordFieldError{{Field: fieldPath, Message: fmt.Sprintf("value '%v' is not an entry of enum '%s'", value, enum.Name)}}
+		}
+		return nil
+	}
+
+	if _, isStructure := r.structures[typeName]; isStructure {
+		nestedData, isMap := value.(map[string]any)
+		if !isMap {
+			return []RecordFieldError{{Field: fieldPath, Message: fmt.Sprintf("expected structure '%s' object, got %T", typeName, value)}}
+		}
+		return r.validateRecordFields(fieldPath+".", r.getStructureRecordFields(typeName), nestedData)
+	}
+
+	message := validatePrimitiveValue(yaml.ModelFieldType(typeName), value)
+	if message != "" {
+		return []RecordFieldError{{Field: fieldPath, Message: message}}
+	}
+	return nil
+}
+
+func (r *Registry) getStructureRecordFields(structureName string) map[string]recordField {
+	structure := r.structures[structureName]
+	fields := make(map[string]recordField, len(structure.Fields))
+	for fieldName, field := range structure.Fields {
+		fields[fieldName] = recordField{Type: string(field.Type), Attributes: field.Attributes}
+	}
+	return fields
+}
+
+// validatePrimitiveValue returns a message describing why the value does not match the primitive type, or an empty string
+func validatePrimitiveValue(fieldType yaml.ModelFieldType, value any) string {
+	switch fieldType {
+	case yaml.ModelFieldTypeString, yaml.ModelFieldTypeProtected, yaml.ModelFieldTypeSealed:
+		if _, isString := value.(string); !isString {
+			return fmt.Sprintf("expected string, got %T", value)
+		}
+	case yaml.ModelFieldTypeUUID:
+		uuidValue, isString := value.(string)
+		if !isString || !uuidPattern.MatchString(uuidValue) {
+			return fmt.Sprintf("value '%v' is not a valid UUID", value)
+		}
+	case yaml.ModelFieldTypeBoolean:
+		if _, isBool := value.(bool); !isBool {
+			return fmt.Sprintf("expected boolean, got %T", value)
+		}
+	case yaml.ModelFieldTypeInteger:
+		if _, isInteger := toInteger(value); !isInteger {
+			return fmt.Sprintf("value '%v' is not an integer in the 64-bit range", value)
+		}
+	case yaml.ModelFieldTypeAutoIncrement:
+		integerValue, isInteger := toInteger(value)
+		if !isInteger || integerValue < 1 {
+			return fmt.Sprintf("value '%v' is not a positive integer", value)
+		}
+	case yaml.ModelFieldTypeFloat:
+		if _, isNumber := toFloat(value); !isNumber {
+			return fmt.Sprintf("expected number, got %T", value)
+		}
+	case yaml.ModelFieldTypeTime:
+		return validateTimeValue(value, time.RFC3339)
+	case yaml.ModelFieldTypeDate:
+		return validateTimeValue(value, DateFormat)
+	default:
+		return fmt.Sprintf("unknown field type '%s'", fieldType)
+	}
+	return ""
+}
+
+func validateTimeValue(value any, layout string) string {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return ""
+	case string:
+		if _, parseErr := time.Parse(layout, typedValue); parseErr != nil {
+			return fmt.Sprintf("value '%s' does not match the format '%s'", typedValue, layout)
+		}
+		return ""
+	}
+	return fmt.Sprintf("expected time or string, got %T", value)
+}
+
+// isRecordEnumValue checks for an entry value, or a combination of entry values for flag enums
+func isRecordEnumValue(enum yaml.Enum, value any) bool {
+	if enum.IsFlag() {
+		return enum.IsFlagValue(value)
+	}
+	_, isEntryValue := enum.KeyFor(value)
+	return isEntryValue
+}
+
+func toInteger(value any) (int64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return int64(typedValue), true
+	case int8:
+		return int64(typedValue), true
+	case int16:
+		return int64(typedValue), true
+	case int32:
+		return int64(typedValue), true
+	case int64:
+		return typedValue, true
+	case uint:
+		return int64(typedValue), uint64(typedValue) <= math.MaxInt64
+	case uint8:
+		return int64(typedValue), true
+	case uint16:
+		return int64(typedValue), true
+	case uint32:
+		return int64(typedValue), true
+	case uint64:
+		return int64(typedValue), typedValue <= math.MaxInt64
+	case json.Number:
+		integerValue, parseErr := typedValue.Int64()
+		return integerValue, parseErr == nil
+	}
+
+	floatValue, isFloat := toFloat(value)
+	if !isFloat || floatValue != math.Trunc(floatValue) || floatValue < math.MinInt64 || floatValue >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(floatValue), true
+}
+
+func toFloat(value any) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue), true
+	case int8:
+		return float64(typedValue), true
+	case int16:
+		return float64(typedValue), true
+	case int32:
+		return float64(typedValue), true
+	case int64:
+		return float64(typedValue), true
+	case uint:
+		return float64(typedValue), true
+	case uint8:
+		return float64(typedValue), true
+	case uint16:
+		return float64(typedValue), true
+	case uint32:
+		return float64(typedValue), true
+	case uint64:
+		return float64(typedValue), true
+	case float32:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case json.Number:
+		floatValue, parseErr := typedValue.Float64()
+		return floatValue, parseErr == nil
+	}
+	return 0, false
+}
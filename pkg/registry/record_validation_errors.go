@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecordFieldError is a single invalid field of a record, nested structure fields are joined with dots
+type RecordFieldError struct {
+	Field   string
+	Message string
+}
+
+func (e RecordFieldError) Error() string {
+	return fmt.Sprintf("field '%s' %s", e.Field, e.Message)
+}
+
+// RecordValidationError lists all invalid fields of a record, sorted by field
+type RecordValidationError struct {
+	Definition string
+	Errors     []RecordFieldError
+}
+
+func (e *RecordValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		messages = append(messages, fieldErr.Error())
+	}
+	return fmt.Sprintf("invalid '%s' record: %s", e.Definition, strings.Join(messages, "; "))
+}
+
+func ErrUnknownRecordDefinition(name string) error {
+	return fmt.Errorf("no model, entity or structure with name '%s' found in registry", name)
+}
+
+func ErrAmbiguousRecordDefinition(name string, kinds []DefinitionKind) error {
+	return fmt.Errorf("name '%s' matches several definition kinds (%s), specify the definition kind", name, joinDefinitionKinds(kinds))
+}
+
+func ErrInvalidRecordJSON(name string, decodeErr error) error {
+	return fmt.Errorf("invalid '%s' record json: %w", name, decodeErr)
+}
+
+func joinDefinitionKinds(kinds []DefinitionKind) string {
+	kindNames := make([]string, len(kinds))
+	for i, kind := range kinds {
+		kindNames[i] = string(kind)
+	}
+	return strings.Join(kindNames, ", ")
+}
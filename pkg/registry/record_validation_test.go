@@ -0,0 +1,150 @@
+package registry_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+func (suite *RegistryTestSuite) getRecordRegistry() *registry.Registry {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	return r
+}
+
+func getRecordFieldErrors(err error) map[string]string {
+	var validationErr *registry.RecordValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	fieldErrors := map[string]string{}
+	for _, fieldErr := range validationErr.Errors {
+		fieldErrors[fieldErr.Field] = fieldErr.Message
+	}
+	return fieldErrors
+}
+
+func (suite *RegistryTestSuite) TestValidateRecord_Valid() {
+	r := suite.getRecordRegistry()
+
+	recordErr := r.ValidateModelRecord("Person", map[string]any{
+		"UUID":        "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		"FirstName":   "Ada",
+		"Age":         36,
+		"Salary":      9.5,
+		"Active":      true,
+		"BirthDate":   "1815-12-10",
+		"CreatedAt":   time.Now(),
+		"Nationality": "American",
+		"Level":       2,
+		"Address":     map[string]any{"Street": "Main St", "ZipCode": 12345},
+	})
+
+	suite.NoError(recordErr)
+}
+
+func (suite *RegistryTestSuite) TestValidateRecord_FieldErrors() {
+	r := suite.getRecordRegistry()
+
+	recordErr := r.ValidateModelRecord("Person", map[string]any{
+		"ID":          0,
+		"UUID":        "not-a-uuid",
+		"Age":         1.5,
+		"Salary":      "high",
+		"Active":      "yes",
+		"BirthDate":   "10/12/1815",
+		"CreatedAt":   "2024-01-01",
+		"Nationality": "French",
+		"Level":       3,
+		"Address":     map[string]any{"ZipCode": "1234AB", "Country": "NL"},
+		"Nickname":    "Ada",
+	})
+
+	suite.Equal(map[string]string{
+		"ID":              "value '0' is not a positive integer",
+		"UUID":            "value 'not-a-uuid' is not a valid UUID",
+		"FirstName":       "is mandatory",
+		"Age":             "value '1.5' is not an integer in the 64-bit range",
+		"Salary":          "expected number, got string",
+		"Active":          "expected boolean, got string",
+		"BirthDate":       "value '10/12/1815' does not match the format '2006-01-02'",
+		"CreatedAt":       "value '2024-01-01' does not match the format '2006-01-02T15:04:05Z07:00'",
+		"Nationality":     "value 'French' is not an entry of enum 'Nationality'",
+		"Level":           "value '3' is not an entry of enum 'Level'",
+		"Address.Country": "is not a known field",
+		"Address.Street":  "is mandatory",
+		"Address.ZipCode": "value '1234AB' is not an integer in the 64-bit range",
+		"Nickname":        "is not a known field",
+	}, getRecordFieldErrors(recordErr))
+	suite.ErrorContains(recordErr, "invalid 'Person' record: field 'Active' expected boolean, got string;")
+}
+
+func (suite *RegistryTestSuite) TestValidateRecordJSON() {
+	r := suite.getRecordRegistry()
+
+	validErr := r.ValidateRecordJSON("Address", []byte(`{"Street": "Main St", "ZipCode": 12345}`))
+	invalidErr := r.ValidateRecordJSON("Address", []byte(`{"Street": "Main St", "ZipCode": 9223372036854775808}`))
+	malformedErr := r.ValidateRecordJSON("Address", []byte(`{"Street": `))
+
+	suite.NoError(validErr)
+	suite.Equal(map[string]string{"ZipCode": "value '9223372036854775808' is not an integer in the 64-bit range"}, getRecordFieldErrors(invalidErr))
+	suite.ErrorContains(malformedErr, "invalid 'Address' record json")
+}
+
+func (suite *RegistryTestSuite) TestDecodeRecordJSON() {
+	r := suite.getRecordRegistry()
+
+	data, decodeErr := registry.DecodeRecordJSON("Person", []byte(`{"UUID": "3f2504e0-4f89-11d3-9a0c-0305e82c3301", "FirstName": "Ada", "Age": 9223372036854775808}`))
+	suite.Require().NoError(decodeErr)
+
+	suite.Equal(map[string]string{"Age": "value '9223372036854775808' is not an integer in the 64-bit range"}, getRecordFieldErrors(r.ValidateModelRecord("Person", data)))
+}
+
+func (suite *RegistryTestSuite) TestValidateRecord_AmbiguousName() {
+	r := suite.getRecordRegistry()
+
+	recordErr := r.ValidateRecord("Person", map[string]any{"ID": 1, "FirstName": "Ada"})
+	jsonErr := r.ValidateRecordJSON("Person", []byte(`{"ID": 1, "FirstName": "Ada"}`))
+	kind, kindErr := r.GetRecordDefinitionKind("Address")
+
+	suite.ErrorContains(recordErr, "name 'Person' matches several definition kinds (model, entity), specify the definition kind")
+	suite.ErrorContains(jsonErr, "name 'Person' matches several definition kinds (model, entity)")
+	suite.Require().NoError(kindErr)
+	suite.Equal(registry.DefinitionKindStructure, kind)
+}
+
+func (suite *RegistryTestSuite) TestValidateEntityRecord() {
+	r := suite.getRecordRegistry()
+
+	validErr := r.ValidateEntityRecord("Person", map[string]any{"ID": 1, "FirstName": "Ada", "Nationality": "German", "OrderCount": 2})
+	invalidErr := r.ValidateEntityRecord("Person", map[string]any{"ID": 1, "Nationality": "French"})
+
+	suite.NoError(validErr)
+	suite.Equal(map[string]string{
+		"FirstName":   "is mandatory",
+		"Nationality": "value 'French' is not an entry of enum 'Nationality'",
+	}, getRecordFieldErrors(invalidErr))
+}
+
+func (suite *RegistryTestSuite) TestValidateStructureRecord() {
+	r := suite.getRecordRegistry()
+
+	suite.NoError(r.ValidateRecord("Address", map[string]any{"Street": "Main St"}))
+	suite.Equal(map[string]string{"Street": "expected string, got int"}, getRecordFieldErrors(r.ValidateStructureRecord("Address", map[string]any{"Street": 1})))
+}
+
+func (suite *RegistryTestSuite) TestValidateRecord_UnknownDefinition() {
+	r := suite.getRecordRegistry()
+
+	suite.ErrorContains(r.ValidateRecord("Unknown", map[string]any{}), "no model, entity or structure with name 'Unknown' found in registry")
+}
+
+func (suite *RegistryTestSuite) TestValidateRecord_FlagEnum() {
+	r := suite.getRecordRegistry()
+
+	suite.NoError(r.ValidateRecord("Grant", map[string]any{"Permission": 3}))
+	suite.NoError(r.ValidateRecord("Grant", map[string]any{"Permission": 0}))
+	suite.Equal(map[string]string{"Permission": "value '4' is not an entry of enum 'Permission'"}, getRecordFieldErrors(r.ValidateRecord("Grant", map[string]any{"Permission": 4})))
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/kalo-build/clone"
+	"github.com/kalo-build/go-util/core"
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 	"github.com/kalo-build/morphe-go/pkg/yamlfile"
 )
@@ -15,6 +16,7 @@ const EnumFileSuffix = ".enum"
 const ModelFileSuffix = ".mod"
 const EntityFileSuffix = ".ent"
 const StructureFileSuffix = ".str"
+const MixinFileSuffix = ".mix"
 
 type Registry struct {
 	mutex sync.RWMutex
@@ -23,6 +25,20 @@ type Registry struct {
 	models     map[string]yaml.Model     `yaml:"models"`
 	structures map[string]yaml.Structure `yaml:"structures"`
 	entities   map[string]yaml.Entity    `yaml:"entities"`
+	mixins     map[string]yaml.Mixin     `yaml:"mixins"`
+
+	// modelMixins maps each loaded model to the mixins that were flattened into it
+	modelMixins map[string][]string
+
+	// definitionFiles maps the absolute path of each loaded file to the definition it declares
+	definitionFiles map[string]DefinitionRef
+
+	// defaultSpecVersion applies to loaded files without a 'morphe' spec version key
+	defaultSpecVersion yamlfile.SpecVersion
+
+	// directoryNamespaces loads nested directories, each nested directory declares the namespace of its definitions
+	directoryNamespaces bool
+	loadWarnings        []yamlfile.DecodeWarning
 }
 
 // ValidateRegistry checks if the registry state is valid
@@ -59,6 +75,13 @@ func (r *Registry) HasStructures() bool {
 	return len(r.structures) > 0
 }
 
+// HasMixins returns true if the registry has mixins defined
+func (r *Registry) HasMixins() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.mixins) > 0
+}
+
 // HasEntities returns true if the registry has entities defined
 func (r *Registry) HasEntities() bool {
 	r.mutex.RLock()
@@ -150,6 +173,55 @@ func (r *Registry) GetAllModels() map[string]yaml.Model {
 	return modelsClone
 }
 
+// SetMixin is a thread-safe way to write a mixin to the registry, models set afterwards are not flattened
+func (r *Registry) SetMixin(name string, mixin yaml.Mixin) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.mixins == nil {
+		r.mixins = make(map[string]yaml.Mixin)
+	}
+
+	r.mixins[name] = mixin
+}
+
+// GetMixin returns a thread-safe copy of a registry mixin
+func (r *Registry) GetMixin(name string) (yaml.Mixin, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.mixins == nil {
+		return yaml.Mixin{}, fmt.Errorf("no mixins in registry, mixin with name '%s' not found", name)
+	}
+
+	mixin, mixinFound := r.mixins[name]
+	if !mixinFound {
+		return yaml.Mixin{}, fmt.Errorf("mixin with name '%s' not found registry", name)
+	}
+	mixinClone := mixin.DeepClone()
+	return mixinClone, nil
+}
+
+// GetAllMixins returns a thread-safe copy of all registry mixins
+func (r *Registry) GetAllMixins() map[string]yaml.Mixin {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.mixins == nil {
+		return make(map[string]yaml.Mixin)
+	}
+
+	mixinsClone := clone.DeepCloneMap(r.mixins)
+	return mixinsClone
+}
+
+// GetModelMixins returns the names of the mixins that were flattened into a loaded model
+func (r *Registry) GetModelMixins(modelName string) []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return clone.Slice(r.modelMixins[modelName])
+}
+
 // SetEntity is a thread-safe way to write an entity to the registry
 func (r *Registry) SetEntity(name string, entity yaml.Entity) {
 	r.mutex.Lock()
@@ -192,6 +264,45 @@ func (r *Registry) GetAllEntities() map[string]yaml.Entity {
 	return entitiesClone
 }
 
+// ResolveEntityFieldPath returns the full model traversal of a registry entity field
+func (r *Registry) ResolveEntityFieldPath(entityName string, fieldName string) (yaml.ResolvedModelFieldPath, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entity, entityFound := r.entities[entityName]
+	if !entityFound {
+		return yaml.ResolvedModelFieldPath{}, fmt.Errorf("entity with name '%s' not found registry", entityName)
+	}
+	return entity.ResolveFieldPath(fieldName, r.models, r.enums, r.structures)
+}
+
+// ResolveEntityFieldExpression returns the resolved expression of a registry entity field, including computed fields
+func (r *Registry) ResolveEntityFieldExpression(entityName string, fieldName string) (yaml.ResolvedEntityFieldExpression, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entity, entityFound := r.entities[entityName]
+	if !entityFound {
+		return yaml.ResolvedEntityFieldExpression{}, fmt.Errorf("entity with name '%s' not found registry", entityName)
+	}
+	return entity.ResolveFieldExpression(fieldName, r.models, r.enums, r.structures)
+}
+
+// GetDeprecationWarnings reports all model and entity members that depend on deprecated items, the registry leaves reporting them to the caller
+func (r *Registry) GetDeprecationWarnings() []yaml.DeprecationWarning {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	warnings := []yaml.DeprecationWarning{}
+	for _, modelName := range core.MapKeysSorted(r.models) {
+		warnings = append(warnings, r.models[modelName].GetDeprecationWarnings(r.models, r.enums)...)
+	}
+	for _, entityName := range core.MapKeysSorted(r.entities) {
+		warnings = append(warnings, r.entities[entityName].GetDeprecationWarnings(r.entities, r.models, r.enums)...)
+	}
+	return warnings
+}
+
 // SetStructure is a thread-safe way to write a structure to the registry
 func (r *Registry) SetStructure(name string, structure yaml.Structure) {
 	r.mutex.Lock()
@@ -243,6 +354,10 @@ func (r *Registry) DeepClone() *Registry {
 		models:     make(map[string]yaml.Model),
 		structures: make(map[string]yaml.Structure),
 		entities:   make(map[string]yaml.Entity),
+		mixins:     make(map[string]yaml.Mixin),
+
+		modelMixins:     make(map[string][]string),
+		definitionFiles: make(map[string]DefinitionRef),
 	}
 
 	if r.enums != nil {
@@ -261,6 +376,20 @@ func (r *Registry) DeepClone() *Registry {
 		registryCopy.entities = clone.DeepCloneMap(r.entities)
 	}
 
+	if r.mixins != nil {
+		registryCopy.mixins = clone.DeepCloneMap(r.mixins)
+	}
+
+	for modelName, mixinNames := range r.modelMixins {
+		registryCopy.modelMixins[modelName] = clone.Slice(mixinNames)
+	}
+	for filePath, ref := range r.definitionFiles {
+		registryCopy.definitionFiles[filePath] = ref
+	}
+	registryCopy.defaultSpecVersion = r.defaultSpecVersion
+	registryCopy.directoryNamespaces = r.directoryNamespaces
+	registryCopy.loadWarnings = clone.Slice(r.loadWarnings)
+
 	return registryCopy
 }
 
@@ -273,14 +402,20 @@ func (r *Registry) LoadEnumsFromDirectory(dirPath string) error {
 		return nil
 	}
 
-	allEnums, unmarshalErr := yamlfile.UnmarshalAllYAMLFiles[yaml.Enum](dirPath, EnumFileSuffix)
+	allEnums, warnings, unmarshalErr := unmarshalDefinitionFiles[yaml.Enum](r, dirPath, EnumFileSuffix, yamlfile.EnumSpecUpgrades)
 	if unmarshalErr != nil {
 		return unmarshalErr
 	}
+	r.addLoadWarnings(warnings)
 
 	// Normalize whitespace in string fields
 	yaml.NormalizeAllEnums(allEnums)
 
+	namespacesErr := applyDirectoryNamespaces(dirPath, allEnums, func(definition *yaml.Enum) *string { return &definition.Namespace })
+	if namespacesErr != nil {
+		return namespacesErr
+	}
+
 	if len(allEnums) == 0 {
 		log.Printf("Warning: No enum files found in directory: %s. Skipping enum loading.", dirPath)
 		return nil
@@ -290,6 +425,38 @@ func (r *Registry) LoadEnumsFromDirectory(dirPath string) error {
 	return loadErr
 }
 
+func (r *Registry) LoadMixinsFromDirectory(dirPath string) error {
+	// Check if directory exists
+	if exists, err := directoryExists(dirPath); err != nil {
+		return err
+	} else if !exists {
+		log.Printf("Warning: Mixins directory does not exist: %s. Skipping mixin loading.", dirPath)
+		return nil
+	}
+
+	allMixins, warnings, unmarshalErr := unmarshalDefinitionFiles[yaml.Mixin](r, dirPath, MixinFileSuffix, yamlfile.MixinSpecUpgrades)
+	if unmarshalErr != nil {
+		return unmarshalErr
+	}
+	r.addLoadWarnings(warnings)
+
+	// Normalize whitespace in string fields
+	yaml.NormalizeAllMixins(allMixins)
+
+	namespacesErr := applyDirectoryNamespaces(dirPath, allMixins, func(definition *yaml.Mixin) *string { return &definition.Namespace })
+	if namespacesErr != nil {
+		return namespacesErr
+	}
+
+	if len(allMixins) == 0 {
+		log.Printf("Warning: No mixin files found in directory: %s. Skipping mixin loading.", dirPath)
+		return nil
+	}
+
+	loadErr := r.loadMixinDefinitions(allMixins)
+	return loadErr
+}
+
 func (r *Registry) LoadModelsFromDirectory(dirPath string) error {
 	// Check if directory exists
 	if exists, err := directoryExists(dirPath); err != nil {
@@ -299,14 +466,20 @@ func (r *Registry) LoadModelsFromDirectory(dirPath string) error {
 		return nil
 	}
 
-	allModels, unmarshalErr := yamlfile.UnmarshalAllYAMLFiles[yaml.Model](dirPath, ModelFileSuffix)
+	allModels, warnings, unmarshalErr := unmarshalDefinitionFiles[yaml.Model](r, dirPath, ModelFileSuffix, yamlfile.ModelSpecUpgrades)
 	if unmarshalErr != nil {
 		return unmarshalErr
 	}
+	r.addLoadWarnings(warnings)
 
 	// Normalize whitespace in string fields
 	yaml.NormalizeAllModels(allModels)
 
+	namespacesErr := applyDirectoryNamespaces(dirPath, allModels, func(definition *yaml.Model) *string { return &definition.Namespace })
+	if namespacesErr != nil {
+		return namespacesErr
+	}
+
 	if len(allModels) == 0 {
 		log.Printf("Warning: No model files found in directory: %s. Skipping model loading.", dirPath)
 		return nil
@@ -325,14 +498,20 @@ func (r *Registry) LoadEntitiesFromDirectory(dirPath string) error {
 		return nil
 	}
 
-	allEntities, unmarshalErr := yamlfile.UnmarshalAllYAMLFiles[yaml.Entity](dirPath, EntityFileSuffix)
+	allEntities, warnings, unmarshalErr := unmarshalDefinitionFiles[yaml.Entity](r, dirPath, EntityFileSuffix, yamlfile.EntitySpecUpgrades)
 	if unmarshalErr != nil {
 		return unmarshalErr
 	}
+	r.addLoadWarnings(warnings)
 
 	// Normalize whitespace in string fields
 	yaml.NormalizeAllEntities(allEntities)
 
+	namespacesErr := applyDirectoryNamespaces(dirPath, allEntities, func(definition *yaml.Entity) *string { return &definition.Namespace })
+	if namespacesErr != nil {
+		return namespacesErr
+	}
+
 	if len(allEntities) == 0 {
 		log.Printf("Warning: No entity files found in directory: %s. Skipping entity loading.", dirPath)
 		return nil
@@ -356,14 +535,20 @@ func (r *Registry) LoadStructuresFromDirectory(dirPath string) error {
 		return nil
 	}
 
-	allStructures, unmarshalErr := yamlfile.UnmarshalAllYAMLFiles[yaml.Structure](dirPath, StructureFileSuffix)
+	allStructures, warnings, unmarshalErr := unmarshalDefinitionFiles[yaml.Structure](r, dirPath, StructureFileSuffix, yamlfile.StructureSpecUpgrades)
 	if unmarshalErr != nil {
 		return unmarshalErr
 	}
+	r.addLoadWarnings(warnings)
 
 	// Normalize whitespace in string fields
 	yaml.NormalizeAllStructures(allStructures)
 
+	namespacesErr := applyDirectoryNamespaces(dirPath, allStructures, func(definition *yaml.Structure) *string { return &definition.Namespace })
+	if namespacesErr != nil {
+		return namespacesErr
+	}
+
 	if len(allStructures) == 0 {
 		log.Printf("Warning: No structure files found in directory: %s. Skipping structure loading.", dirPath)
 		return nil
@@ -373,6 +558,49 @@ func (r *Registry) LoadStructuresFromDirectory(dirPath string) error {
 	return loadErr
 }
 
+// SetDirectoryNamespaces sets whether loading reads nested directories, each nested directory then declares the namespace of its definitions
+func (r *Registry) SetDirectoryNamespaces(enabled bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.directoryNamespaces = enabled
+}
+
+// SetDefaultSpecVersion sets the spec version of loaded files that do not declare one, such as the version of the project manifest
+func (r *Registry) SetDefaultSpecVersion(version yamlfile.SpecVersion) error {
+	if !version.IsSupported() {
+		return yamlfile.ErrUnsupportedSpecVersion(version)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.defaultSpecVersion = version
+	return nil
+}
+
+// GetLoadWarnings returns the deprecation warnings of all files loaded so far, the registry leaves reporting them to the caller
+func (r *Registry) GetLoadWarnings() []yamlfile.DecodeWarning {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return clone.Slice(r.loadWarnings)
+}
+
+func (r *Registry) getDecodeOptions(upgrades []yamlfile.SpecUpgrade) yamlfile.DecodeOptions {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return yamlfile.DecodeOptions{
+		DefaultSpecVersion: r.defaultSpecVersion,
+		Upgrades:           upgrades,
+	}
+}
+
+func (r *Registry) addLoadWarnings(warnings []yamlfile.DecodeWarning) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, warning := range warnings {
+		r.loadWarnings = append(r.loadWarnings, warning)
+	}
+}
+
 // Helper function to check if a directory exists
 func directoryExists(path string) (bool, error) {
 	info, err := os.Stat(path)
@@ -394,12 +622,14 @@ func (r *Registry) loadEnumDefinitions(allEnums map[string]yaml.Enum) error {
 	}
 
 	for enumPathAbs, enum := range allEnums {
+		enum = enum.QualifyNamespace()
 		_, nameConflict := r.enums[enum.Name]
 		if nameConflict {
 			return fmt.Errorf("enum name '%s' already exists in registry (conflict: %s)", enum.Name, enumPathAbs)
 		}
 
 		r.enums[enum.Name] = enum
+		r.setDefinitionFile(enumPathAbs, DefinitionKindEnum, enum.Name)
 	}
 	return nil
 }
@@ -412,13 +642,31 @@ func (r *Registry) loadModelDefinitions(allModels map[string]yaml.Model) error {
 		r.models = make(map[string]yaml.Model)
 	}
 
+	modelNames := getQualifiedNames(allModels, func(model yaml.Model) (string, string) { return model.Namespace, model.Name })
+	isModel := func(name string) bool {
+		_, isLoaded := r.models[name]
+		return isLoaded || modelNames[name]
+	}
 	for modelPathAbs, model := range allModels {
-		_, nameConflict := r.models[model.Name]
+		modelName := yaml.QualifyName(model.Namespace, model.Name)
+		_, nameConflict := r.models[modelName]
 		if nameConflict {
-			return fmt.Errorf("model name '%s' already exists in registry (conflict: %s)", model.Name, modelPathAbs)
+			return fmt.Errorf("model name '%s' already exists in registry (conflict: %s)", modelName, modelPathAbs)
 		}
 
-		r.models[model.Name] = model
+		model = model.QualifyIncludes(hasDefinition(r.mixins))
+		mixinNames, resolveErr := yaml.ResolveMixinIncludes(model.Name, model.Includes, r.mixins)
+		if resolveErr != nil {
+			return fmt.Errorf("%w (file: %s)", resolveErr, modelPathAbs)
+		}
+		flattened, mixinsErr := model.ApplyMixins(r.mixins)
+		if mixinsErr != nil {
+			return fmt.Errorf("%w (file: %s)", mixinsErr, modelPathAbs)
+		}
+
+		r.models[modelName] = flattened.QualifyNamespace(r.isTypeName, isModel)
+		r.setModelMixins(modelName, mixinNames)
+		r.setDefinitionFile(modelPathAbs, DefinitionKindModel, modelName)
 	}
 	return nil
 }
@@ -431,13 +679,29 @@ func (r *Registry) loadEntityDefinitions(allEntities map[string]yaml.Entity) err
 		r.entities = make(map[string]yaml.Entity)
 	}
 
+	entityNames := getQualifiedNames(allEntities, func(entity yaml.Entity) (string, string) { return entity.Namespace, entity.Name })
+	isModel := func(name string) bool {
+		_, isLoaded := r.models[name]
+		return isLoaded
+	}
+	isEntity := func(name string) bool {
+		_, isLoaded := r.entities[name]
+		return isLoaded || entityNames[name]
+	}
 	for entityPathAbs, entity := range allEntities {
+		entity = entity.QualifyNamespace(isModel, isEntity)
 		_, nameConflict := r.entities[entity.Name]
 		if nameConflict {
 			return fmt.Errorf("entity name '%s' already exists in registry (conflict: %s)", entity.Name, entityPathAbs)
 		}
 
-		r.entities[entity.Name] = entity
+		expanded, includesErr := entity.ExpandIncludes(r.models)
+		if includesErr != nil {
+			return fmt.Errorf("%w (file: %s)", includesErr, entityPathAbs)
+		}
+
+		r.entities[entity.Name] = expanded
+		r.setDefinitionFile(entityPathAbs, DefinitionKindEntity, entity.Name)
 	}
 
 	// Check if models are defined when entities are loaded
@@ -456,13 +720,60 @@ func (r *Registry) loadStructureDefinitions(allStructures map[string]yaml.Struct
 		r.structures = make(map[string]yaml.Structure)
 	}
 
+	structureNames := getQualifiedNames(allStructures, func(structure yaml.Structure) (string, string) { return structure.Namespace, structure.Name })
+	isType := func(name string) bool {
+		return r.isTypeName(name) || structureNames[name]
+	}
 	for structurePathAbs, structure := range allStructures {
+		structure = structure.QualifyNamespace(isType)
 		_, nameConflict := r.structures[structure.Name]
 		if nameConflict {
 			return fmt.Errorf("structure name '%s' already exists in registry (conflict: %s)", structure.Name, structurePathAbs)
 		}
 
 		r.structures[structure.Name] = structure
+		r.setDefinitionFile(structurePathAbs, DefinitionKindStructure, structure.Name)
 	}
 	return nil
 }
+
+func (r *Registry) loadMixinDefinitions(allMixins map[string]yaml.Mixin) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.mixins == nil {
+		r.mixins = make(map[string]yaml.Mixin)
+	}
+
+	mixinNames := getQualifiedNames(allMixins, func(mixin yaml.Mixin) (string, string) { return mixin.Namespace, mixin.Name })
+	isMixin := func(name string) bool {
+		_, isLoaded := r.mixins[name]
+		return isLoaded || mixinNames[name]
+	}
+	for mixinPathAbs, mixin := range allMixins {
+		validateErr := mixin.Validate()
+		if validateErr != nil {
+			return fmt.Errorf("%w (file: %s)", validateErr, mixinPathAbs)
+		}
+		mixin = mixin.QualifyNamespace(r.isTypeName, isMixin)
+		_, nameConflict := r.mixins[mixin.Name]
+		if nameConflict {
+			return fmt.Errorf("mixin name '%s' already exists in registry (conflict: %s)", mixin.Name, mixinPathAbs)
+		}
+
+		r.mixins[mixin.Name] = mixin
+		r.setDefinitionFile(mixinPathAbs, DefinitionKindMixin, mixin.Name)
+	}
+	return nil
+}
+
+// setModelMixins records the mixins flattened into a model, the caller must hold the write lock
+func (r *Registry) setModelMixins(modelName string, mixinNames []string) {
+	if len(mixinNames) == 0 {
+		return
+	}
+	if r.modelMixins == nil {
+		r.modelMixins = make(map[string][]string)
+	}
+	r.modelMixins[modelName] = mixinNames
+}
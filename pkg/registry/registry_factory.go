@@ -8,5 +8,9 @@ func NewRegistry() *Registry {
 		models:     map[string]yaml.Model{},
 		structures: map[string]yaml.Structure{},
 		entities:   map[string]yaml.Entity{},
+		mixins:     map[string]yaml.Mixin{},
+
+		modelMixins:     map[string][]string{},
+		definitionFiles: map[string]DefinitionRef{},
 	}
 }
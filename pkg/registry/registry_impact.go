@@ -0,0 +1,269 @@
+package registry
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// DefinitionKind is the kind of a registry definition
+type DefinitionKind string
+
+const (
+	DefinitionKindEnum      DefinitionKind = "enum"
+	DefinitionKindModel     DefinitionKind = "model"
+	DefinitionKindStructure DefinitionKind = "structure"
+	DefinitionKindEntity    DefinitionKind = "entity"
+	DefinitionKindMixin     DefinitionKind = "mixin"
+)
+
+// definitionKindOrder is the order in which definition kinds are listed
+var definitionKindOrder = map[DefinitionKind]int{
+	DefinitionKindEnum:      0,
+	DefinitionKindModel:     1,
+	DefinitionKindStructure: 2,
+	DefinitionKindEntity:    3,
+	DefinitionKindMixin:     4,
+}
+
+// DefinitionRef references a registry definition by kind and name
+type DefinitionRef struct {
+	Kind DefinitionKind
+	Name string
+}
+
+// setDefinitionFile records the file a definition was loaded from, the caller must hold the write lock
+func (r *Registry) setDefinitionFile(filePathAbs string, kind DefinitionKind, name string) {
+	if r.definitionFiles == nil {
+		r.definitionFiles = make(map[string]DefinitionRef)
+	}
+	r.definitionFiles[filepath.Clean(filePathAbs)] = DefinitionRef{Kind: kind, Name: name}
+}
+
+// GetDefinitionForFile returns the definition that was loaded from the specified file
+func (r *Registry) GetDefinitionForFile(filePath string) (DefinitionRef, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	filePathAbs, absErr := filepath.Abs(filePath)
+	if absErr != nil {
+		return DefinitionRef{}, false
+	}
+	ref, found := r.definitionFiles[filePathAbs]
+	return ref, found
+}
+
+// GetDependencies returns the definitions the specified definition directly references, sorted by kind and name
+func (r *Registry) GetDependencies(ref DefinitionRef) []DefinitionRef {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return sortDefinitionRefs(r.getDependencies(ref))
+}
+
+// GetAffectedDefinitions returns the changed definitions together with all definitions that transitively depend on them, sorted by kind and name.
+// Changed definitions that no longer exist in the registry are only used to find their dependents.
+func (r *Registry) GetAffectedDefinitions(changed []DefinitionRef) []DefinitionRef {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	dependents := r.getAllDependents()
+	affected := map[DefinitionRef]bool{}
+	pending := append([]DefinitionRef{}, changed...)
+	for len(pending) > 0 {
+		ref := pending[0]
+		pending = pending[1:]
+		if affected[ref] {
+			continue
+		}
+		affected[ref] = true
+		pending = append(pending, dependents[ref]...)
+	}
+
+	affectedRefs := []DefinitionRef{}
+	for ref := range affected {
+		if r.hasDefinition(ref) {
+			affectedRefs = append(affectedRefs, ref)
+		}
+	}
+	return sortDefinitionRefs(affectedRefs)
+}
+
+// GetAffectedDefinitionsForFiles returns all definitions affected by changes to the specified files.
+// Files that were not loaded into the registry are ignored.
+func (r *Registry) GetAffectedDefinitionsForFiles(filePaths []string) []DefinitionRef {
+	changed := []DefinitionRef{}
+	for _, filePath := range filePaths {
+		ref, found := r.GetDefinitionForFile(filePath)
+		if found {
+			changed = append(changed, ref)
+		}
+	}
+	return r.GetAffectedDefinitions(changed)
+}
+
+func (r *Registry) hasDefinition(ref DefinitionRef) bool {
+	var exists bool
+	switch ref.Kind {
+	case DefinitionKindEnum:
+		_, exists = r.enums[ref.Name]
+	case DefinitionKindModel:
+		_, exists = r.models[ref.Name]
+	case DefinitionKindStructure:
+		_, exists = r.structures[ref.Name]
+	case DefinitionKindEntity:
+		_, exists = r.entities[ref.Name]
+	case DefinitionKindMixin:
+		_, exists = r.mixins[ref.Name]
+	}
+	return exists
+}
+
+// getAllDependents inverts the dependencies of all definitions, the caller must hold the read lock
+func (r *Registry) getAllDependents() map[DefinitionRef][]DefinitionRef {
+	allRefs := []DefinitionRef{}
+	for name := range r.enums {
+		allRefs = append(allRefs, DefinitionRef{Kind: DefinitionKindEnum, Name: name})
+	}
+	for name := range r.models {
+		allRefs = append(allRefs, DefinitionRef{Kind: DefinitionKindModel, Name: name})
+	}
+	for name := range r.structures {
+		allRefs = append(allRefs, DefinitionRef{Kind: DefinitionKindStructure, Name: name})
+	}
+	for name := range r.entities {
+		allRefs = append(allRefs, DefinitionRef{Kind: DefinitionKindEntity, Name: name})
+	}
+	for name := range r.mixins {
+		allRefs = append(allRefs, DefinitionRef{Kind: DefinitionKindMixin, Name: name})
+	}
+
+	dependents := map[DefinitionRef][]DefinitionRef{}
+	for _, ref := range allRefs {
+		for _, dependency := range r.getDependencies(ref) {
+			dependents[dependency] = append(dependents[dependency], ref)
+		}
+	}
+	return dependents
+}
+
+// getDependencies returns the unsorted direct dependencies of a definition, the caller must hold the read lock
+func (r *Registry) getDependencies(ref DefinitionRef) []DefinitionRef {
+	dependencies := map[DefinitionRef]bool{}
+	switch ref.Kind {
+	case DefinitionKindModel:
+		r.addModelDependencies(dependencies, r.models[ref.Name])
+	case DefinitionKindStructure:
+		r.addStructureDependencies(dependencies, r.structures[ref.Name])
+	case DefinitionKindEntity:
+		r.addEntityDependencies(dependencies, r.entities[ref.Name])
+	case DefinitionKindMixin:
+		r.addMixinDependencies(dependencies, r.mixins[ref.Name])
+	}
+	delete(dependencies, ref)
+
+	dependencyRefs := make([]DefinitionRef, 0, len(dependencies))
+	for dependency := range dependencies {
+		dependencyRefs = append(dependencyRefs, dependency)
+	}
+	return dependencyRefs
+}
+
+func (r *Registry) addModelDependencies(dependencies map[DefinitionRef]bool, model yaml.Model) {
+	for _, field := range model.Fields {
+		r.addTypeDependency(dependencies, string(field.Type))
+	}
+	for _, mixinName := range r.modelMixins[model.Name] {
+		dependencies[DefinitionRef{Kind: DefinitionKindMixin, Name: mixinName}] = true
+	}
+	for relationName, relation := range model.Related {
+		dependencies[DefinitionRef{Kind: DefinitionKindModel, Name: getRelationTargetName(relationName, relation.Aliased)}] = true
+		for _, forName := range relation.For {
+			dependencies[DefinitionRef{Kind: DefinitionKindModel, Name: forName}] = true
+		}
+	}
+}
+
+func (r *Registry) addMixinDependencies(dependencies map[DefinitionRef]bool, mixin yaml.Mixin) {
+	for _, field := range mixin.Fields {
+		r.addTypeDependency(dependencies, string(field.Type))
+	}
+	for _, mixinName := range mixin.Includes {
+		dependencies[DefinitionRef{Kind: DefinitionKindMixin, Name: mixinName}] = true
+	}
+}
+
+func (r *Registry) addStructureDependencies(dependencies map[DefinitionRef]bool, structure yaml.Structure) {
+	for _, field := range structure.Fields {
+		r.addTypeDependency(dependencies, string(field.Type))
+	}
+}
+
+func (r *Registry) addEntityDependencies(dependencies map[DefinitionRef]bool, entity yaml.Entity) {
+	for fieldName, field := range entity.Fields {
+		resolved, resolveErr := entity.ResolveFieldExpression(fieldName, r.models, r.enums, r.structures)
+		if resolveErr != nil {
+			// Invalid paths still depend on the models they name, so fix-ups of those models are picked up
+			r.addUnresolvedPathDependencies(dependencies, field.Type)
+			continue
+		}
+		for _, resolvedPath := range resolved.Paths {
+			for _, model := range resolvedPath.Models {
+				dependencies[DefinitionRef{Kind: DefinitionKindModel, Name: model.Name}] = true
+			}
+			if resolvedPath.Enum != nil {
+				dependencies[DefinitionRef{Kind: DefinitionKindEnum, Name: resolvedPath.Enum.Name}] = true
+			}
+			if resolvedPath.Structure != nil {
+				dependencies[DefinitionRef{Kind: DefinitionKindStructure, Name: resolvedPath.Structure.Name}] = true
+			}
+		}
+	}
+	for relationName, relation := range entity.Related {
+		dependencies[DefinitionRef{Kind: DefinitionKindEntity, Name: getRelationTargetName(relationName, relation.Aliased)}] = true
+		for _, forName := range relation.For {
+			dependencies[DefinitionRef{Kind: DefinitionKindEntity, Name: forName}] = true
+		}
+	}
+}
+
+func (r *Registry) addUnresolvedPathDependencies(dependencies map[DefinitionRef]bool, fieldType yaml.ModelFieldPath) {
+	expression, parseErr := yaml.ParseEntityFieldExpression(fieldType)
+	if parseErr != nil {
+		return
+	}
+	for _, path := range expression.GetPaths() {
+		rootModelName := yaml.SplitModelFieldPath(path)[0]
+		dependencies[DefinitionRef{Kind: DefinitionKindModel, Name: rootModelName}] = true
+	}
+}
+
+func (r *Registry) addTypeDependency(dependencies map[DefinitionRef]bool, typeName string) {
+	if _, isEnum := r.enums[typeName]; isEnum {
+		dependencies[DefinitionRef{Kind: DefinitionKindEnum, Name: typeName}] = true
+	}
+	if _, isStructure := r.structures[typeName]; isStructure {
+		dependencies[DefinitionRef{Kind: DefinitionKindStructure, Name: typeName}] = true
+	}
+}
+
+// getRelationTargetName returns the aliased target of a relation, without any polymorphic inverse suffix, or the relation name
+func getRelationTargetName(relationName string, aliased string) string {
+	targetName := strings.TrimSpace(aliased)
+	if targetName == "" {
+		return relationName
+	}
+	return yaml.GetAliasedTargetName(targetName)
+}
+
+func sortDefinitionRefs(refs []DefinitionRef) []DefinitionRef {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Kind != refs[j].Kind {
+			return definitionKindOrder[refs[i].Kind] < definitionKindOrder[refs[j].Kind]
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
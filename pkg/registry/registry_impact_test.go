@@ -0,0 +1,120 @@
+package registry_test
+
+import (
+	"path/filepath"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func getImpactRegistry() *registry.Registry {
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", yaml.Enum{Name: "Nationality", Type: yaml.EnumTypeString, Entries: map[string]any{"US": "American"}})
+	r.SetEnum("Unused", yaml.Enum{Name: "Unused", Type: yaml.EnumTypeString, Entries: map[string]any{"A": "a"}})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":          {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Nationality": {Type: "Nationality"},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"ContactInfo": {Type: "HasOne"},
+		},
+	})
+	r.SetModel("ContactInfo", yaml.Model{
+		Name: "ContactInfo",
+		Fields: map[string]yaml.ModelField{
+			"ID":    {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Email": {Type: yaml.ModelFieldTypeString},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Person": {Type: "ForOne"},
+		},
+	})
+	r.SetModel("Company", yaml.Model{
+		Name:   "Company",
+		Fields: map[string]yaml.ModelField{"ID": {Type: yaml.ModelFieldTypeAutoIncrement}},
+	})
+	r.SetEntity("Person", yaml.Entity{
+		Name: "Person",
+		Fields: map[string]yaml.EntityField{
+			"ID":    {Type: "Person.ID"},
+			"Email": {Type: "Person.ContactInfo.Email"},
+		},
+	})
+	r.SetEntity("Employer", yaml.Entity{
+		Name:   "Employer",
+		Fields: map[string]yaml.EntityField{"ID": {Type: "Company.ID"}},
+		Related: map[string]yaml.EntityRelation{
+			"Staff": {Type: "HasMany", Aliased: "Person"},
+		},
+	})
+	return r
+}
+
+func (suite *RegistryTestSuite) TestGetDependencies() {
+	r := getImpactRegistry()
+
+	suite.Equal([]registry.DefinitionRef{
+		{Kind: registry.DefinitionKindEnum, Name: "Nationality"},
+		{Kind: registry.DefinitionKindModel, Name: "ContactInfo"},
+	}, r.GetDependencies(registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "Person"}))
+
+	suite.Equal([]registry.DefinitionRef{
+		{Kind: registry.DefinitionKindModel, Name: "ContactInfo"},
+		{Kind: registry.DefinitionKindModel, Name: "Person"},
+	}, r.GetDependencies(registry.DefinitionRef{Kind: registry.DefinitionKindEntity, Name: "Person"}))
+
+	suite.Empty(r.GetDependencies(registry.DefinitionRef{Kind: registry.DefinitionKindEnum, Name: "Nationality"}))
+}
+
+func (suite *RegistryTestSuite) TestGetAffectedDefinitions_Enum() {
+	r := getImpactRegistry()
+
+	affected := r.GetAffectedDefinitions([]registry.DefinitionRef{{Kind: registry.DefinitionKindEnum, Name: "Nationality"}})
+
+	suite.Equal([]registry.DefinitionRef{
+		{Kind: registry.DefinitionKindEnum, Name: "Nationality"},
+		{Kind: registry.DefinitionKindModel, Name: "ContactInfo"},
+		{Kind: registry.DefinitionKindModel, Name: "Person"},
+		{Kind: registry.DefinitionKindEntity, Name: "Employer"},
+		{Kind: registry.DefinitionKindEntity, Name: "Person"},
+	}, affected)
+}
+
+func (suite *RegistryTestSuite) TestGetAffectedDefinitions_Unreferenced() {
+	r := getImpactRegistry()
+
+	affected := r.GetAffectedDefinitions([]registry.DefinitionRef{{Kind: registry.DefinitionKindModel, Name: "Company"}})
+
+	suite.Equal([]registry.DefinitionRef{
+		{Kind: registry.DefinitionKindModel, Name: "Company"},
+		{Kind: registry.DefinitionKindEntity, Name: "Employer"},
+	}, affected)
+}
+
+func (suite *RegistryTestSuite) TestGetAffectedDefinitions_RemovedDefinition() {
+	r := getImpactRegistry()
+
+	affected := r.GetAffectedDefinitions([]registry.DefinitionRef{{Kind: registry.DefinitionKindEnum, Name: "Removed"}})
+
+	suite.Empty(affected)
+}
+
+func (suite *RegistryTestSuite) TestGetAffectedDefinitionsForFiles() {
+	r := registry.NewRegistry()
+	suite.Nil(r.LoadEnumsFromDirectory(suite.EnumsDirPath))
+	suite.Nil(r.LoadModelsFromDirectory(suite.ModelsDirPath))
+	suite.Nil(r.LoadEntitiesFromDirectory(suite.EntitiesDirPath))
+
+	contactInfoPath := filepath.Join(suite.ModelsDirPath, "contact-info.mod")
+	ref, found := r.GetDefinitionForFile(contactInfoPath)
+	suite.True(found)
+	suite.Equal(registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "ContactInfo"}, ref)
+
+	affected := r.GetAffectedDefinitionsForFiles([]string{contactInfoPath, filepath.Join(suite.ModelsDirPath, "unknown.mod")})
+
+	suite.Contains(affected, registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "ContactInfo"})
+	suite.Contains(affected, registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "Person"})
+	suite.Contains(affected, registry.DefinitionRef{Kind: registry.DefinitionKindEntity, Name: "Person"})
+}
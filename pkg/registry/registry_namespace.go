@@ -0,0 +1,142 @@
+package registry
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlfile"
+)
+
+// GetEnumInNamespace returns a thread-safe copy of the enum a name refers to from within a namespace
+func (r *Registry) GetEnumInNamespace(namespace string, name string) (yaml.Enum, error) {
+	r.mutex.RLock()
+	qualifiedName := yaml.ResolveNamespacedName(namespace, name, hasDefinition(r.enums))
+	r.mutex.RUnlock()
+	return r.GetEnum(qualifiedName)
+}
+
+// GetModelInNamespace returns a thread-safe copy of the model a name refers to from within a namespace
+func (r *Registry) GetModelInNamespace(namespace string, name string) (yaml.Model, error) {
+	r.mutex.RLock()
+	qualifiedName := yaml.ResolveNamespacedName(namespace, name, hasDefinition(r.models))
+	r.mutex.RUnlock()
+	return r.GetModel(qualifiedName)
+}
+
+// GetStructureInNamespace returns a thread-safe copy of the structure a name refers to from within a namespace
+func (r *Registry) GetStructureInNamespace(namespace string, name string) (yaml.Structure, error) {
+	r.mutex.RLock()
+	qualifiedName := yaml.ResolveNamespacedName(namespace, name, hasDefinition(r.structures))
+	r.mutex.RUnlock()
+	return r.GetStructure(qualifiedName)
+}
+
+// GetEntityInNamespace returns a thread-safe copy of the entity a name refers to from within a namespace
+func (r *Registry) GetEntityInNamespace(namespace string, name string) (yaml.Entity, error) {
+	r.mutex.RLock()
+	qualifiedName := yaml.ResolveNamespacedName(namespace, name, hasDefinition(r.entities))
+	r.mutex.RUnlock()
+	return r.GetEntity(qualifiedName)
+}
+
+// GetNamespaces returns the sorted namespaces of all registry definitions
+func (r *Registry) GetNamespaces() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	namespaces := map[string]bool{}
+	addNamespaces(namespaces, r.enums)
+	addNamespaces(namespaces, r.models)
+	addNamespaces(namespaces, r.structures)
+	addNamespaces(namespaces, r.entities)
+	return core.MapKeysSorted(namespaces)
+}
+
+func addNamespaces[TDefinition any](namespaces map[string]bool, definitions map[string]TDefinition) {
+	for name := range definitions {
+		namespace, _ := yaml.SplitQualifiedName(name)
+		if namespace != "" {
+			namespaces[namespace] = true
+		}
+	}
+}
+
+func hasDefinition[TDefinition any](definitions map[string]TDefinition) func(string) bool {
+	return func(name string) bool {
+		_, exists := definitions[name]
+		return exists
+	}
+}
+
+// isTypeName checks for an enum or structure with the specified name, the caller must hold the lock
+func (r *Registry) isTypeName(name string) bool {
+	_, isEnum := r.enums[name]
+	_, isStructure := r.structures[name]
+	return isEnum || isStructure
+}
+
+// getQualifiedNames returns the set of qualified names of a batch of loaded definitions
+func getQualifiedNames[TDefinition any](allDefinitions map[string]TDefinition, getName func(TDefinition) (string, string)) map[string]bool {
+	qualifiedNames := make(map[string]bool, len(allDefinitions))
+	for _, definition := range allDefinitions {
+		namespace, name := getName(definition)
+		qualifiedNames[yaml.QualifyName(namespace, name)] = true
+	}
+	return qualifiedNames
+}
+
+// unmarshalDefinitionFiles reads the definition files of a directory, and of its nested directories if directory namespaces are enabled
+func unmarshalDefinitionFiles[TDefinition any](r *Registry, dirPath string, fileSuffix string, upgrades []yamlfile.SpecUpgrade) (map[string]TDefinition, []yamlfile.DecodeWarning, error) {
+	r.mutex.RLock()
+	directoryNamespaces := r.directoryNamespaces
+	r.mutex.RUnlock()
+
+	options := r.getDecodeOptions(upgrades)
+	if directoryNamespaces {
+		return yamlfile.UnmarshalAllVersionedYAMLFilesInTree[TDefinition](dirPath, fileSuffix, options)
+	}
+	return yamlfile.UnmarshalAllVersionedYAMLFiles[TDefinition](dirPath, fileSuffix, options)
+}
+
+// applyDirectoryNamespaces sets the namespace of each definition from its subdirectory, such as 'billing/eu' for 'billing.eu'.
+// An explicit namespace key must agree with the subdirectory of nested files.
+func applyDirectoryNamespaces[TDefinition any](dirPath string, allDefinitions map[string]TDefinition, getNamespace func(*TDefinition) *string) error {
+	for _, filePath := range core.MapKeysSorted(allDefinitions) {
+		definition := allDefinitions[filePath]
+		namespace := getNamespace(&definition)
+
+		dirNamespace, relErr := getDirectoryNamespace(dirPath, filePath)
+		if relErr != nil {
+			return relErr
+		}
+		if dirNamespace != "" && *namespace != "" && *namespace != dirNamespace {
+			return ErrConflictingNamespace(filePath, *namespace, dirNamespace)
+		}
+		if *namespace == "" {
+			*namespace = dirNamespace
+		}
+		if *namespace != "" && !yaml.IsValidNamespace(*namespace) {
+			return ErrInvalidNamespace(filePath, *namespace)
+		}
+		allDefinitions[filePath] = definition
+	}
+	return nil
+}
+
+func getDirectoryNamespace(dirPath string, filePath string) (string, error) {
+	relDirPath, relErr := filepath.Rel(dirPath, filepath.Dir(filePath))
+	if relErr != nil {
+		return "", relErr
+	}
+	if relDirPath == "." {
+		return "", nil
+	}
+	segments := strings.Split(filepath.ToSlash(relDirPath), "/")
+	if slices.Contains(segments, "..") {
+		return "", nil
+	}
+	return strings.Join(segments, yaml.NamespaceSeparator), nil
+}
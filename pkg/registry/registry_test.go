@@ -767,3 +767,57 @@ func (suite *RegistryTestSuite) TestLoadStructuresFromDirectory_ConflictingName(
 	conflictPath := filepath.Join(suite.StructuresDirPath, "address.str")
 	suite.Contains(structuresErrMsg, conflictPath)
 }
+
+func (suite *RegistryTestSuite) TestResolveEntityFieldPath() {
+	r := registry.NewRegistry()
+
+	suite.Nil(r.LoadModelsFromDirectory(suite.ModelsDirPath))
+	suite.Nil(r.LoadEntitiesFromDirectory(suite.EntitiesDirPath))
+
+	resolved, resolveErr := r.ResolveEntityFieldPath("Person", "Email")
+	suite.Nil(resolveErr)
+
+	suite.Equal(yaml.ModelFieldPath("Person.ContactInfo.Email"), resolved.Path)
+	suite.Len(resolved.Hops, 1)
+	suite.Equal("ContactInfo", resolved.Hops[0].RelationName)
+	suite.Equal(yaml.RelationCardinalityOne, resolved.Hops[0].Cardinality)
+	suite.Equal("ContactInfo", resolved.TerminalModel().Name)
+	suite.Equal(yaml.ModelFieldTypeString, resolved.Primitive)
+}
+
+func (suite *RegistryTestSuite) TestResolveEntityFieldPath_UnknownEntity() {
+	r := registry.NewRegistry()
+
+	_, resolveErr := r.ResolveEntityFieldPath("Unknown", "Email")
+	suite.ErrorContains(resolveErr, "entity with name 'Unknown' not found")
+}
+
+func (suite *RegistryTestSuite) TestResolveEntityFieldExpression() {
+	r := registry.NewRegistry()
+
+	suite.Nil(r.LoadModelsFromDirectory(suite.ModelsDirPath))
+	suite.Nil(r.LoadEntitiesFromDirectory(suite.EntitiesDirPath))
+
+	resolved, resolveErr := r.ResolveEntityFieldExpression("Person", "FirstName")
+	suite.Nil(resolveErr)
+
+	suite.False(resolved.Expression.IsComputed())
+	suite.Len(resolved.Paths, 1)
+	suite.Equal(yaml.ModelFieldTypeString, resolved.Type)
+}
+
+func (suite *RegistryTestSuite) TestGetDeprecationWarnings() {
+	r := getImpactRegistry()
+	suite.Empty(r.GetDeprecationWarnings())
+
+	contactInfo, _ := r.GetModel("ContactInfo")
+	email := contactInfo.Fields["Email"]
+	email.Deprecated = &yaml.Deprecation{Since: "2.0"}
+	contactInfo.Fields["Email"] = email
+	r.SetModel("ContactInfo", contactInfo)
+
+	warnings := r.GetDeprecationWarnings()
+
+	suite.Len(warnings, 1)
+	suite.Equal("entity 'Person' field 'Email' depends on deprecated model 'ContactInfo' field 'Email' (since 2.0)", warnings[0].String())
+}
@@ -0,0 +1,205 @@
+package registrydiff
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// memberProperty is a named, comparable property of a definition member
+type memberProperty struct {
+	Name  string
+	Value any
+}
+
+func compareDefinitions[TDefinition any](kind DefinitionKind, before map[string]TDefinition, after map[string]TDefinition, compareMembers func(TDefinition, TDefinition) []MemberChange) []DefinitionDiff {
+	diffs := []DefinitionDiff{}
+	for _, name := range getSortedNameUnion(before, after) {
+		beforeDefinition, inBefore := before[name]
+		afterDefinition, inAfter := after[name]
+		switch {
+		case !inBefore:
+			diffs = append(diffs, DefinitionDiff{Kind: kind, Name: name, Change: ChangeTypeAdded})
+		case !inAfter:
+			diffs = append(diffs, DefinitionDiff{Kind: kind, Name: name, Change: ChangeTypeRemoved})
+		default:
+			members := compareMembers(beforeDefinition, afterDefinition)
+			if len(members) == 0 {
+				continue
+			}
+			diffs = append(diffs, DefinitionDiff{Kind: kind, Name: name, Change: ChangeTypeChanged, Members: members})
+		}
+	}
+	return diffs
+}
+
+// compareFields compares named fields by type and attributes
+func compareFields[TField any](before map[string]TField, after map[string]TField, getType func(TField) string, getAttributes func(TField) []string) []MemberChange {
+	changes := []MemberChange{}
+	for _, fieldName := range getSortedNameUnion(before, after) {
+		beforeField, inBefore := before[fieldName]
+		afterField, inAfter := after[fieldName]
+		switch {
+		case !inBefore:
+			changes = append(changes, MemberChange{Member: MemberKindField, Name: fieldName, Change: ChangeTypeAdded, After: getType(afterField)})
+		case !inAfter:
+			changes = append(changes, MemberChange{Member: MemberKindField, Name: fieldName, Change: ChangeTypeRemoved, Before: getType(beforeField)})
+		default:
+			beforeType := getType(beforeField)
+			afterType := getType(afterField)
+			if beforeType != afterType {
+				changes = append(changes, MemberChange{
+					Member:   MemberKindField,
+					Name:     fieldName,
+					Change:   ChangeTypeChanged,
+					Property: "type",
+					Before:   beforeType,
+					After:    afterType,
+				})
+			}
+			changes = append(changes, compareAttributes(fieldName, getAttributes(beforeField), getAttributes(afterField))...)
+		}
+	}
+	return changes
+}
+
+func compareAttributes(fieldName string, before []string, after []string) []MemberChange {
+	changes := []MemberChange{}
+	for _, attribute := range getSortedSliceUnion(before, after) {
+		inBefore := slices.Contains(before, attribute)
+		inAfter := slices.Contains(after, attribute)
+		if inBefore && inAfter {
+			continue
+		}
+		change := MemberChange{Member: MemberKindAttribute, Name: attribute, Field: fieldName, Change: ChangeTypeAdded}
+		if !inAfter {
+			change.Change = ChangeTypeRemoved
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func compareIdentifiers(before map[string][]string, after map[string][]string) []MemberChange {
+	changes := []MemberChange{}
+	for _, identifierName := range getSortedNameUnion(before, after) {
+		beforeFields, inBefore := before[identifierName]
+		afterFields, inAfter := after[identifierName]
+		switch {
+		case !inBefore:
+			changes = append(changes, MemberChange{Member: MemberKindIdentifier, Name: identifierName, Change: ChangeTypeAdded, After: afterFields})
+		case !inAfter:
+			changes = append(changes, MemberChange{Member: MemberKindIdentifier, Name: identifierName, Change: ChangeTypeRemoved, Before: beforeFields})
+		case !slices.Equal(beforeFields, afterFields):
+			changes = append(changes, MemberChange{
+				Member:   MemberKindIdentifier,
+				Name:     identifierName,
+				Change:   ChangeTypeChanged,
+				Property: "fields",
+				Before:   beforeFields,
+				After:    afterFields,
+			})
+		}
+	}
+	return changes
+}
+
+// compareRelations compares named relations property by property
+func compareRelations[TRelation any](before map[string]TRelation, after map[string]TRelation, getProperties func(TRelation) []memberProperty) []MemberChange {
+	changes := []MemberChange{}
+	for _, relationName := range getSortedNameUnion(before, after) {
+		beforeRelation, inBefore := before[relationName]
+		afterRelation, inAfter := after[relationName]
+		switch {
+		case !inBefore:
+			changes = append(changes, MemberChange{Member: MemberKindRelation, Name: relationName, Change: ChangeTypeAdded, After: getProperties(afterRelation)[0].Value})
+		case !inAfter:
+			changes = append(changes, MemberChange{Member: MemberKindRelation, Name: relationName, Change: ChangeTypeRemoved, Before: getProperties(beforeRelation)[0].Value})
+		default:
+			beforeProperties := getProperties(beforeRelation)
+			afterProperties := getProperties(afterRelation)
+			for propertyIdx, beforeProperty := range beforeProperties {
+				afterProperty := afterProperties[propertyIdx]
+				if reflect.DeepEqual(beforeProperty.Value, afterProperty.Value) {
+					continue
+				}
+				changes = append(changes, MemberChange{
+					Member:   MemberKindRelation,
+					Name:     relationName,
+					Change:   ChangeTypeChanged,
+					Property: beforeProperty.Name,
+					Before:   beforeProperty.Value,
+					After:    afterProperty.Value,
+				})
+			}
+		}
+	}
+	return changes
+}
+
+// compareDeprecation compares the deprecation of a single item, the item kind is recorded as the change property
+func compareDeprecation(itemKind string, name string, before *yaml.Deprecation, after *yaml.Deprecation) []MemberChange {
+	change := MemberChange{Member: MemberKindDeprecation, Name: name, Property: itemKind}
+	switch {
+	case before == nil && after == nil:
+		return nil
+	case before == nil:
+		change.Change = ChangeTypeAdded
+		change.After = *after
+	case after == nil:
+		change.Change = ChangeTypeRemoved
+		change.Before = *before
+	case *before != *after:
+		change.Change = ChangeTypeChanged
+		change.Before = *before
+		change.After = *after
+	default:
+		return nil
+	}
+	return []MemberChange{change}
+}
+
+// compareMemberDeprecations compares the deprecations of all members that exist before and after
+func compareMemberDeprecations[TMember any](itemKind string, before map[string]TMember, after map[string]TMember, getDeprecation func(TMember) *yaml.Deprecation) []MemberChange {
+	changes := []MemberChange{}
+	for _, memberName := range getSortedNameUnion(before, after) {
+		beforeMember, inBefore := before[memberName]
+		afterMember, inAfter := after[memberName]
+		if !inBefore || !inAfter {
+			continue
+		}
+		changes = append(changes, compareDeprecation(itemKind, memberName, getDeprecation(beforeMember), getDeprecation(afterMember))...)
+	}
+	return changes
+}
+
+func getSortedNameUnion[TValue any](before map[string]TValue, after map[string]TValue) []string {
+	names := core.MapKeys(before)
+	for name := range after {
+		if _, inBefore := before[name]; !inBefore {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func getSortedSliceUnion(before []string, after []string) []string {
+	union := slices.Clone(before)
+	for _, value := range after {
+		if !slices.Contains(union, value) {
+			union = append(union, value)
+		}
+	}
+	slices.Sort(union)
+	return slices.Compact(union)
+}
+
+func normalizeStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return slices.Clone(values)
+}
@@ -0,0 +1,54 @@
+package registrydiff
+
+import (
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// CompareEntities computes the differences between two sets of entities keyed by name
+func CompareEntities(before map[string]yaml.Entity, after map[string]yaml.Entity) []DefinitionDiff {
+	return compareDefinitions(DefinitionKindEntity, before, after, compareEntityMembers)
+}
+
+func compareEntityMembers(before yaml.Entity, after yaml.Entity) []MemberChange {
+	changes := compareFields(before.Fields, after.Fields, getEntityFieldType, getEntityFieldAttributes)
+	changes = append(changes, compareIdentifiers(getEntityIdentifierFields(before), getEntityIdentifierFields(after))...)
+	changes = append(changes, compareRelations(before.Related, after.Related, getEntityRelationProperties)...)
+	changes = append(changes, compareDeprecation("entity", before.Name, before.Deprecated, after.Deprecated)...)
+	changes = append(changes, compareMemberDeprecations("field", before.Fields, after.Fields, getEntityFieldDeprecation)...)
+	changes = append(changes, compareMemberDeprecations("relation", before.Related, after.Related, getEntityRelationDeprecation)...)
+	return changes
+}
+
+func getEntityFieldDeprecation(field yaml.EntityField) *yaml.Deprecation {
+	return field.Deprecated
+}
+
+func getEntityRelationDeprecation(relation yaml.EntityRelation) *yaml.Deprecation {
+	return relation.Deprecated
+}
+
+func getEntityFieldType(field yaml.EntityField) string {
+	return string(field.Type)
+}
+
+func getEntityFieldAttributes(field yaml.EntityField) []string {
+	return field.Attributes
+}
+
+func getEntityIdentifierFields(entity yaml.Entity) map[string][]string {
+	identifierFields := make(map[string][]string, len(entity.Identifiers))
+	for identifierName, identifier := range entity.Identifiers {
+		identifierFields[identifierName] = identifier.Fields
+	}
+	return identifierFields
+}
+
+// getEntityRelationProperties lists the comparable relation properties, starting with the relation type
+func getEntityRelationProperties(relation yaml.EntityRelation) []memberProperty {
+	return []memberProperty{
+		{Name: "type", Value: relation.Type},
+		{Name: "for", Value: normalizeStrings(relation.For)},
+		{Name: "through", Value: relation.Through},
+		{Name: "aliased", Value: relation.Aliased},
+	}
+}
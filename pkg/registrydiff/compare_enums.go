@@ -0,0 +1,103 @@
+package registrydiff
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// CompareEnums computes the differences between two sets of enums keyed by name
+func CompareEnums(before map[string]yaml.Enum, after map[string]yaml.Enum) []DefinitionDiff {
+	return compareDefinitions(DefinitionKindEnum, before, after, compareEnumMembers)
+}
+
+func compareEnumMembers(before yaml.Enum, after yaml.Enum) []MemberChange {
+	changes := []MemberChange{}
+	if before.Type != after.Type {
+		changes = append(changes, MemberChange{
+			Member:   MemberKindEnumType,
+			Name:     "type",
+			Change:   ChangeTypeChanged,
+			Property: "type",
+			Before:   string(before.Type),
+			After:    string(after.Type),
+		})
+	}
+	if before.Kind != after.Kind {
+		changes = append(changes, MemberChange{
+			Member:   MemberKindEnumKind,
+			Name:     "kind",
+			Change:   ChangeTypeChanged,
+			Property: "kind",
+			Before:   string(before.Kind),
+			After:    string(after.Kind),
+		})
+	}
+
+	for _, entryName := range getSortedNameUnion(before.Entries, after.Entries) {
+		beforeValue, inBefore := before.Entries[entryName]
+		afterValue, inAfter := after.Entries[entryName]
+		switch {
+		case !inBefore:
+			changes = append(changes, MemberChange{Member: MemberKindEnumEntry, Name: entryName, Change: ChangeTypeAdded, After: afterValue})
+		case !inAfter:
+			changes = append(changes, MemberChange{Member: MemberKindEnumEntry, Name: entryName, Change: ChangeTypeRemoved, Before: beforeValue})
+		case !reflect.DeepEqual(beforeValue, afterValue):
+			changes = append(changes, MemberChange{
+				Member:   MemberKindEnumEntry,
+				Name:     entryName,
+				Change:   ChangeTypeChanged,
+				Property: "value",
+				Before:   beforeValue,
+				After:    afterValue,
+			})
+		}
+	}
+
+	if before.Kind == yaml.EnumKindOrdered && after.Kind == yaml.EnumKindOrdered {
+		beforeOrder := getSharedEntryOrder(before, after)
+		afterOrder := getSharedEntryOrder(after, before)
+		if !slices.Equal(beforeOrder, afterOrder) {
+			changes = append(changes, MemberChange{
+				Member:   MemberKindEnumOrder,
+				Name:     "entries",
+				Change:   ChangeTypeChanged,
+				Property: "order",
+				Before:   beforeOrder,
+				After:    afterOrder,
+			})
+		}
+	}
+
+	changes = append(changes, compareDeprecation("enum", before.Name, before.Deprecated, after.Deprecated)...)
+	changes = append(changes, compareMemberDeprecations("entry", getEnumEntryDeprecations(before), getEnumEntryDeprecations(after), getEnumEntryDeprecation)...)
+	return changes
+}
+
+// getSharedEntryOrder returns the entry names of an enum in declaration order, limited to the entries the other enum also has
+func getSharedEntryOrder(enum yaml.Enum, other yaml.Enum) []string {
+	entryNames := []string{}
+	for _, entryName := range enum.GetEntryNames() {
+		if _, exists := other.Entries[entryName]; exists {
+			entryNames = append(entryNames, entryName)
+		}
+	}
+	return entryNames
+}
+
+// getEnumEntryDeprecations maps every entry to its deprecation, or nil if the entry is not deprecated
+func getEnumEntryDeprecations(enum yaml.Enum) map[string]*yaml.Deprecation {
+	deprecations := make(map[string]*yaml.Deprecation, len(enum.Entries))
+	for entryName := range enum.Entries {
+		deprecations[entryName] = nil
+		if deprecation, isDeprecated := enum.DeprecatedEntries[entryName]; isDeprecated {
+			deprecations[entryName] = &deprecation
+		}
+	}
+	return deprecations
+}
+
+func getEnumEntryDeprecation(deprecation *yaml.Deprecation) *yaml.Deprecation {
+	return deprecation
+}
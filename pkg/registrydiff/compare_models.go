@@ -0,0 +1,62 @@
+package registrydiff
+
+import (
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// CompareModels computes the differences between two sets of models keyed by name
+func CompareModels(before map[string]yaml.Model, after map[string]yaml.Model) []DefinitionDiff {
+	return compareDefinitions(DefinitionKindModel, before, after, compareModelMembers)
+}
+
+func compareModelMembers(before yaml.Model, after yaml.Model) []MemberChange {
+	changes := compareFields(before.Fields, after.Fields, getModelFieldType, getModelFieldAttributes)
+	changes = append(changes, compareIdentifiers(getModelIdentifierFields(before), getModelIdentifierFields(after))...)
+	changes = append(changes, compareRelations(before.Related, after.Related, getModelRelationProperties)...)
+	changes = append(changes, compareDeprecation("model", before.Name, before.Deprecated, after.Deprecated)...)
+	changes = append(changes, compareMemberDeprecations("field", before.Fields, after.Fields, getModelFieldDeprecation)...)
+	changes = append(changes, compareMemberDeprecations("relation", before.Related, after.Related, getModelRelationDeprecation)...)
+	return changes
+}
+
+func getModelFieldDeprecation(field yaml.ModelField) *yaml.Deprecation {
+	return field.Deprecated
+}
+
+func getModelRelationDeprecation(relation yaml.ModelRelation) *yaml.Deprecation {
+	return relation.Deprecated
+}
+
+func getModelFieldType(field yaml.ModelField) string {
+	return string(field.Type)
+}
+
+func getModelFieldAttributes(field yaml.ModelField) []string {
+	return field.Attributes
+}
+
+func getModelIdentifierFields(model yaml.Model) map[string][]string {
+	identifierFields := make(map[string][]string, len(model.Identifiers))
+	for identifierName, identifier := range model.Identifiers {
+		identifierFields[identifierName] = identifier.Fields
+	}
+	return identifierFields
+}
+
+// getModelRelationProperties lists the comparable relation properties, starting with the relation type
+func getModelRelationProperties(relation yaml.ModelRelation) []memberProperty {
+	orderBy := relation.OrderBy.Field
+	if relation.OrderBy.Direction != "" {
+		orderBy += " " + string(relation.OrderBy.Direction)
+	}
+	return []memberProperty{
+		{Name: "type", Value: relation.Type},
+		{Name: "for", Value: normalizeStrings(relation.For)},
+		{Name: "through", Value: relation.Through},
+		{Name: "aliased", Value: relation.Aliased},
+		{Name: "required", Value: relation.Required},
+		{Name: "onDelete", Value: string(relation.OnDelete)},
+		{Name: "foreignKey", Value: relation.ForeignKey},
+		{Name: "orderBy", Value: orderBy},
+	}
+}
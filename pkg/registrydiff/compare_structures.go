@@ -0,0 +1,22 @@
+package registrydiff
+
+import (
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// CompareStructures computes the differences between two sets of structures keyed by name
+func CompareStructures(before map[string]yaml.Structure, after map[string]yaml.Structure) []DefinitionDiff {
+	return compareDefinitions(DefinitionKindStructure, before, after, compareStructureMembers)
+}
+
+func compareStructureMembers(before yaml.Structure, after yaml.Structure) []MemberChange {
+	return compareFields(before.Fields, after.Fields, getStructureFieldType, getStructureFieldAttributes)
+}
+
+func getStructureFieldType(field yaml.StructureField) string {
+	return string(field.Type)
+}
+
+func getStructureFieldAttributes(field yaml.StructureField) []string {
+	return field.Attributes
+}
@@ -0,0 +1,281 @@
+package registrydiff
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// Compatibility is the impact of a change on existing consumers of a schema
+type Compatibility string
+
+const (
+	// CompatibilitySafe changes do not affect the shape consumers rely on
+	CompatibilitySafe Compatibility = "safe"
+	// CompatibilityAdditive changes only add new definitions or members
+	CompatibilityAdditive Compatibility = "additive"
+	// CompatibilityBreaking changes can break existing consumers or data
+	CompatibilityBreaking Compatibility = "breaking"
+)
+
+// ClassifiedChange is a definition or member change together with its compatibility
+type ClassifiedChange struct {
+	Kind             DefinitionKind `json:"kind"`
+	Definition       string         `json:"definition"`
+	DefinitionChange ChangeType     `json:"definitionChange"`
+
+	// Member is nil for changes of the definition itself
+	Member *MemberChange `json:"member,omitempty"`
+
+	Compatibility Compatibility `json:"compatibility"`
+	Reason        string        `json:"reason"`
+}
+
+// CompatibilityReport lists every classified change between two registries in diff order
+type CompatibilityReport struct {
+	Changes []ClassifiedChange `json:"changes"`
+}
+
+// IsBreaking returns true if any change is breaking
+func (r CompatibilityReport) IsBreaking() bool {
+	return len(r.GetBreaking()) > 0
+}
+
+// GetBreaking returns all breaking changes
+func (r CompatibilityReport) GetBreaking() []ClassifiedChange {
+	breaking := []ClassifiedChange{}
+	for _, change := range r.Changes {
+		if change.Compatibility == CompatibilityBreaking {
+			breaking = append(breaking, change)
+		}
+	}
+	return breaking
+}
+
+// CheckCompatibility compares two registries and classifies every change
+func CheckCompatibility(before *registry.Registry, after *registry.Registry) CompatibilityReport {
+	return Classify(Compare(before, after), after)
+}
+
+// Classify classifies every change of a registry diff, using the after registry for details of added members
+func Classify(diff RegistryDiff, after *registry.Registry) CompatibilityReport {
+	if after == nil {
+		after = registry.NewRegistry()
+	}
+
+	report := CompatibilityReport{
+		Changes: []ClassifiedChange{},
+	}
+	for _, definitionDiff := range diff.GetAll() {
+		report.Changes = append(report.Changes, classifyDefinitionDiff(definitionDiff, after)...)
+	}
+	return report
+}
+
+func classifyDefinitionDiff(definitionDiff DefinitionDiff, after *registry.Registry) []ClassifiedChange {
+	definitionChange := ClassifiedChange{
+		Kind:             definitionDiff.Kind,
+		Definition:       definitionDiff.Name,
+		DefinitionChange: definitionDiff.Change,
+	}
+
+	switch definitionDiff.Change {
+	case ChangeTypeAdded:
+		definitionChange.Compatibility = CompatibilityAdditive
+		definitionChange.Reason = fmt.Sprintf("%s '%s' was added", definitionDiff.Kind, definitionDiff.Name)
+		return []ClassifiedChange{definitionChange}
+	case ChangeTypeRemoved:
+		definitionChange.Compatibility = CompatibilityBreaking
+		definitionChange.Reason = fmt.Sprintf("%s '%s' was removed", definitionDiff.Kind, definitionDiff.Name)
+		return []ClassifiedChange{definitionChange}
+	}
+
+	changes := make([]ClassifiedChange, 0, len(definitionDiff.Members))
+	for memberIdx := range definitionDiff.Members {
+		member := definitionDiff.Members[memberIdx]
+		memberChange := definitionChange
+		memberChange.Member = &member
+		memberChange.Compatibility, memberChange.Reason = classifyMemberChange(definitionDiff, member, after)
+		changes = append(changes, memberChange)
+	}
+	return changes
+}
+
+func classifyMemberChange(definitionDiff DefinitionDiff, member MemberChange, after *registry.Registry) (Compatibility, string) {
+	switch member.Member {
+	case MemberKindEnumType:
+		return CompatibilityBreaking, fmt.Sprintf("enum type changed from '%v' to '%v'", member.Before, member.After)
+	case MemberKindEnumKind:
+		return CompatibilityBreaking, fmt.Sprintf("enum kind changed from '%v' to '%v'", member.Before, member.After)
+	case MemberKindEnumOrder:
+		return CompatibilityBreaking, fmt.Sprintf("ordered enum entries were reordered from %v to %v", member.Before, member.After)
+	case MemberKindEnumEntry:
+		return classifyEnumEntryChange(member)
+	case MemberKindField:
+		return classifyFieldChange(definitionDiff, member, after)
+	case MemberKindAttribute:
+		return classifyAttributeChange(member)
+	case MemberKindIdentifier:
+		return classifyIdentifierChange(member)
+	case MemberKindRelation:
+		return classifyRelationChange(definitionDiff, member, after)
+	case MemberKindDeprecation:
+		return classifyDeprecationChange(member)
+	}
+	return CompatibilityBreaking, fmt.Sprintf("unknown %s change", member.Member)
+}
+
+func classifyEnumEntryChange(member MemberChange) (Compatibility, string) {
+	switch member.Change {
+	case ChangeTypeAdded:
+		return CompatibilityAdditive, fmt.Sprintf("enum entry '%s' was added", member.Name)
+	case ChangeTypeRemoved:
+		return CompatibilityBreaking, fmt.Sprintf("enum entry '%s' was removed", member.Name)
+	}
+	return CompatibilityBreaking, fmt.Sprintf("enum entry '%s' value changed from '%v' to '%v'", member.Name, member.Before, member.After)
+}
+
+func classifyFieldChange(definitionDiff DefinitionDiff, member MemberChange, after *registry.Registry) (Compatibility, string) {
+	switch member.Change {
+	case ChangeTypeAdded:
+		if isAddedFieldMandatory(definitionDiff, member.Name, after) {
+			return CompatibilityBreaking, fmt.Sprintf("mandatory field '%s' was added without a value for existing records", member.Name)
+		}
+		return CompatibilityAdditive, fmt.Sprintf("field '%s' was added", member.Name)
+	case ChangeTypeRemoved:
+		return CompatibilityBreaking, fmt.Sprintf("field '%s' was removed", member.Name)
+	}
+
+	if definitionDiff.Kind == DefinitionKindEntity {
+		return CompatibilityBreaking, fmt.Sprintf("field '%s' path changed from '%v' to '%v'", member.Name, member.Before, member.After)
+	}
+	beforeType := fmt.Sprint(member.Before)
+	afterType := fmt.Sprint(member.After)
+	if isFieldTypeWidening(beforeType, afterType) {
+		return CompatibilitySafe, fmt.Sprintf("field '%s' type widened from '%s' to '%s'", member.Name, beforeType, afterType)
+	}
+	return CompatibilityBreaking, fmt.Sprintf("field '%s' type changed from '%s' to '%s'", member.Name, beforeType, afterType)
+}
+
+func classifyAttributeChange(member MemberChange) (Compatibility, string) {
+	isAdded := member.Change == ChangeTypeAdded
+	switch {
+	case member.Name == "mandatory" && isAdded:
+		return CompatibilityBreaking, fmt.Sprintf("field '%s' became mandatory", member.Field)
+	case member.Name == "mandatory":
+		return CompatibilityBreaking, fmt.Sprintf("field '%s' is no longer mandatory and may be absent", member.Field)
+	case member.Name == "immutable" && isAdded:
+		return CompatibilityBreaking, fmt.Sprintf("field '%s' became immutable", member.Field)
+	}
+	return CompatibilitySafe, fmt.Sprintf("field '%s' attribute '%s' was %s", member.Field, member.Name, member.Change)
+}
+
+func classifyIdentifierChange(member MemberChange) (Compatibility, string) {
+	switch member.Change {
+	case ChangeTypeAdded:
+		return CompatibilityAdditive, fmt.Sprintf("identifier '%s' was added", member.Name)
+	case ChangeTypeRemoved:
+		return CompatibilityBreaking, fmt.Sprintf("identifier '%s' was removed", member.Name)
+	}
+	return CompatibilityBreaking, fmt.Sprintf("identifier '%s' fields changed from %v to %v", member.Name, member.Before, member.After)
+}
+
+func classifyRelationChange(definitionDiff DefinitionDiff, member MemberChange, after *registry.Registry) (Compatibility, string) {
+	switch member.Change {
+	case ChangeTypeAdded:
+		if isAddedRelationRequired(definitionDiff, member.Name, after) {
+			return CompatibilityBreaking, fmt.Sprintf("required relation '%s' was added", member.Name)
+		}
+		return CompatibilityAdditive, fmt.Sprintf("relation '%s' was added", member.Name)
+	case ChangeTypeRemoved:
+		return CompatibilityBreaking, fmt.Sprintf("relation '%s' was removed", member.Name)
+	}
+
+	switch member.Property {
+	case "type":
+		return CompatibilityBreaking, fmt.Sprintf("relation '%s' type changed from '%v' to '%v'", member.Name, member.Before, member.After)
+	case "for":
+		beforeFor, _ := member.Before.([]string)
+		afterFor, _ := member.After.([]string)
+		for _, forName := range beforeFor {
+			if !slices.Contains(afterFor, forName) {
+				return CompatibilityBreaking, fmt.Sprintf("relation '%s' no longer targets '%s'", member.Name, forName)
+			}
+		}
+		return CompatibilityAdditive, fmt.Sprintf("relation '%s' targets were extended to %v", member.Name, afterFor)
+	case "orderBy":
+		return CompatibilitySafe, fmt.Sprintf("relation '%s' default ordering changed from '%v' to '%v'", member.Name, member.Before, member.After)
+	}
+	return CompatibilityBreaking, fmt.Sprintf("relation '%s' %s changed from '%v' to '%v'", member.Name, member.Property, member.Before, member.After)
+}
+
+// classifyDeprecationChange treats deprecations as safe, since deprecated items keep working until they are removed
+func classifyDeprecationChange(member MemberChange) (Compatibility, string) {
+	switch member.Change {
+	case ChangeTypeAdded:
+		deprecation, _ := member.After.(yaml.Deprecation)
+		reason := fmt.Sprintf("%s '%s' was deprecated", member.Property, member.Name)
+		if description := deprecation.Describe(); description != "" {
+			reason += fmt.Sprintf(" (%s)", description)
+		}
+		return CompatibilitySafe, reason
+	case ChangeTypeRemoved:
+		return CompatibilitySafe, fmt.Sprintf("%s '%s' is no longer deprecated", member.Property, member.Name)
+	}
+	deprecation, _ := member.After.(yaml.Deprecation)
+	return CompatibilitySafe, fmt.Sprintf("%s '%s' deprecation changed to '%s'", member.Property, member.Name, deprecation.Describe())
+}
+
+// widenedFieldTypes maps a field type to the types that can represent all of its values.
+// Integers do not widen to Float, which loses precision above 2^53.
+var widenedFieldTypes = map[string][]string{
+	string(yaml.ModelFieldTypeAutoIncrement): {string(yaml.ModelFieldTypeInteger)},
+	string(yaml.ModelFieldTypeDate):          {string(yaml.ModelFieldTypeTime)},
+}
+
+func isFieldTypeWidening(beforeType string, afterType string) bool {
+	return slices.Contains(widenedFieldTypes[beforeType], afterType)
+}
+
+// isAddedFieldMandatory checks whether an added field must be set on existing records, only AutoIncrement fields are filled automatically
+func isAddedFieldMandatory(definitionDiff DefinitionDiff, fieldName string, after *registry.Registry) bool {
+	var fieldType string
+	var attributes []string
+	switch definitionDiff.Kind {
+	case DefinitionKindModel:
+		model, modelErr := after.GetModel(definitionDiff.Name)
+		if modelErr != nil {
+			return false
+		}
+		fieldType = string(model.Fields[fieldName].Type)
+		attributes = model.Fields[fieldName].Attributes
+	case DefinitionKindStructure:
+		structure, structureErr := after.GetStructure(definitionDiff.Name)
+		if structureErr != nil {
+			return false
+		}
+		fieldType = string(structure.Fields[fieldName].Type)
+		attributes = structure.Fields[fieldName].Attributes
+	case DefinitionKindEntity:
+		entity, entityErr := after.GetEntity(definitionDiff.Name)
+		if entityErr != nil {
+			return false
+		}
+		attributes = entity.Fields[fieldName].Attributes
+	}
+
+	return slices.Contains(attributes, "mandatory") && fieldType != string(yaml.ModelFieldTypeAutoIncrement)
+}
+
+func isAddedRelationRequired(definitionDiff DefinitionDiff, relationName string, after *registry.Registry) bool {
+	if definitionDiff.Kind != DefinitionKindModel {
+		return false
+	}
+	model, modelErr := after.GetModel(definitionDiff.Name)
+	if modelErr != nil {
+		return false
+	}
+	return model.Related[relationName].Required
+}
@@ -0,0 +1,207 @@
+package registrydiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func findClassifiedChange(report registrydiff.CompatibilityReport, definition string, memberName string, property string) (registrydiff.ClassifiedChange, bool) {
+	for _, change := range report.Changes {
+		if change.Definition != definition {
+			continue
+		}
+		if change.Member == nil && memberName == "" {
+			return change, true
+		}
+		if change.Member != nil && change.Member.Name == memberName && change.Member.Property == property {
+			return change, true
+		}
+	}
+	return registrydiff.ClassifiedChange{}, false
+}
+
+func TestCheckCompatibility_NoChanges(t *testing.T) {
+	report := registrydiff.CheckCompatibility(getBeforeRegistry(), getBeforeRegistry())
+
+	assert.Empty(t, report.Changes)
+	assert.False(t, report.IsBreaking())
+}
+
+func TestCheckCompatibility_Classifications(t *testing.T) {
+	report := registrydiff.CheckCompatibility(getBeforeRegistry(), getAfterRegistry())
+	require.True(t, report.IsBreaking())
+
+	testCases := []struct {
+		definition    string
+		member        string
+		property      string
+		compatibility registrydiff.Compatibility
+		reason        string
+	}{
+		{"Legacy", "", "", registrydiff.CompatibilityBreaking, "enum 'Legacy' was removed"},
+		{"Nationality", "FR", "", registrydiff.CompatibilityBreaking, "enum entry 'FR' was removed"},
+		{"Nationality", "IT", "", registrydiff.CompatibilityAdditive, "enum entry 'IT' was added"},
+		{"Nationality", "DE", "value", registrydiff.CompatibilityBreaking, "enum entry 'DE' value changed from 'German' to 'Deutsch'"},
+		{"Company", "", "", registrydiff.CompatibilityAdditive, "model 'Company' was added"},
+		{"Person", "Age", "type", registrydiff.CompatibilityBreaking, "field 'Age' type changed from 'Integer' to 'Float'"},
+		{"Person", "Email", "", registrydiff.CompatibilityAdditive, "field 'Email' was added"},
+		{"Person", "immutable", "", registrydiff.CompatibilityBreaking, "field 'ID' became immutable"},
+		{"Person", "Nickname", "", registrydiff.CompatibilityBreaking, "field 'Nickname' was removed"},
+		{"Person", "name", "fields", registrydiff.CompatibilityBreaking, "identifier 'name' fields changed"},
+		{"Person", "Company", "required", registrydiff.CompatibilityBreaking, "relation 'Company' required changed from 'false' to 'true'"},
+		{"Person", "Pets", "", registrydiff.CompatibilityAdditive, "relation 'Pets' was added"},
+	}
+	for _, testCase := range testCases {
+		change, found := findClassifiedChange(report, testCase.definition, testCase.member, testCase.property)
+		require.True(t, found, testCase.reason)
+		assert.Equal(t, testCase.compatibility, change.Compatibility, testCase.reason)
+		assert.Contains(t, change.Reason, testCase.reason)
+	}
+
+	entityChange := report.Changes[len(report.Changes)-1]
+	assert.Equal(t, registrydiff.DefinitionKindEntity, entityChange.Kind)
+	assert.Equal(t, registrydiff.CompatibilityBreaking, entityChange.Compatibility)
+	assert.Equal(t, "field 'Name' path changed from 'Person.FirstName' to 'Person.Nickname'", entityChange.Reason)
+}
+
+func TestCheckCompatibility_MandatoryFieldAdded(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID": {Type: yaml.ModelFieldTypeAutoIncrement},
+		},
+	})
+
+	after := registry.NewRegistry()
+	after.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":        {Type: yaml.ModelFieldTypeAutoIncrement},
+			"Email":     {Type: yaml.ModelFieldTypeString, Attributes: []string{"mandatory"}},
+			"Sequence":  {Type: yaml.ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+			"Biography": {Type: yaml.ModelFieldTypeString},
+		},
+	})
+
+	report := registrydiff.CheckCompatibility(before, after)
+
+	expected := map[string]registrydiff.Compatibility{
+		"Email":     registrydiff.CompatibilityBreaking,
+		"Sequence":  registrydiff.CompatibilityAdditive,
+		"Biography": registrydiff.CompatibilityAdditive,
+	}
+	for fieldName, compatibility := range expected {
+		change, found := findClassifiedChange(report, "Person", fieldName, "")
+		require.True(t, found, fieldName)
+		assert.Equal(t, compatibility, change.Compatibility, fieldName)
+	}
+}
+
+func TestCheckCompatibility_RelationCardinalityAndTargets(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetModel("Comment", yaml.Model{
+		Name: "Comment",
+		Related: map[string]yaml.ModelRelation{
+			"Commentable": {Type: "ForOnePoly", For: []string{"Post", "Article"}},
+			"Author":      {Type: "ForOne"},
+			"Replies":     {Type: "HasMany", OrderBy: yaml.ModelRelationOrderBy{Field: "CreatedAt"}},
+		},
+	})
+
+	after := registry.NewRegistry()
+	after.SetModel("Comment", yaml.Model{
+		Name: "Comment",
+		Related: map[string]yaml.ModelRelation{
+			"Commentable": {Type: "ForOnePoly", For: []string{"Post", "Article", "Video"}},
+			"Author":      {Type: "ForMany"},
+			"Replies":     {Type: "HasMany", OrderBy: yaml.ModelRelationOrderBy{Field: "CreatedAt", Direction: yaml.ModelRelationOrderDirectionDesc}},
+			"Moderator":   {Type: "ForOne", Required: true},
+		},
+	})
+
+	report := registrydiff.CheckCompatibility(before, after)
+
+	authorChange, authorFound := findClassifiedChange(report, "Comment", "Author", "type")
+	require.True(t, authorFound)
+	assert.Equal(t, registrydiff.CompatibilityBreaking, authorChange.Compatibility)
+	assert.Equal(t, "relation 'Author' type changed from 'ForOne' to 'ForMany'", authorChange.Reason)
+
+	forChange, forFound := findClassifiedChange(report, "Comment", "Commentable", "for")
+	require.True(t, forFound)
+	assert.Equal(t, registrydiff.CompatibilityAdditive, forChange.Compatibility)
+
+	orderChange, orderFound := findClassifiedChange(report, "Comment", "Replies", "orderBy")
+	require.True(t, orderFound)
+	assert.Equal(t, registrydiff.CompatibilitySafe, orderChange.Compatibility)
+
+	moderatorChange, moderatorFound := findClassifiedChange(report, "Comment", "Moderator", "")
+	require.True(t, moderatorFound)
+	assert.Equal(t, registrydiff.CompatibilityBreaking, moderatorChange.Compatibility)
+	assert.Equal(t, "required relation 'Moderator' was added", moderatorChange.Reason)
+
+	assert.Len(t, report.GetBreaking(), 2)
+}
+
+func TestCheckCompatibility_Deprecations(t *testing.T) {
+	before := getBeforeRegistry()
+	after := getBeforeRegistry()
+	nationality, _ := after.GetEnum("Nationality")
+	nationality.DeprecatedEntries = map[string]yaml.Deprecation{"FR": {Since: "2.3", Reason: "merged into EU"}}
+	after.SetEnum("Nationality", nationality)
+	person, _ := after.GetModel("Person")
+	nickname := person.Fields["Nickname"]
+	nickname.Deprecated = &yaml.Deprecation{ReplacedBy: "FirstName"}
+	person.Fields["Nickname"] = nickname
+	person.Deprecated = &yaml.Deprecation{Since: "3.0"}
+	after.SetModel("Person", person)
+
+	report := registrydiff.CheckCompatibility(before, after)
+
+	assert.False(t, report.IsBreaking())
+	entryChange, entryFound := findClassifiedChange(report, "Nationality", "FR", "entry")
+	require.True(t, entryFound)
+	assert.Equal(t, registrydiff.MemberKindDeprecation, entryChange.Member.Member)
+	assert.Equal(t, registrydiff.CompatibilitySafe, entryChange.Compatibility)
+	assert.Equal(t, "entry 'FR' was deprecated (since 2.3: merged into EU)", entryChange.Reason)
+
+	fieldChange, fieldFound := findClassifiedChange(report, "Person", "Nickname", "field")
+	require.True(t, fieldFound)
+	assert.Equal(t, "field 'Nickname' was deprecated (replaced by FirstName)", fieldChange.Reason)
+
+	modelChange, modelFound := findClassifiedChange(report, "Person", "Person", "model")
+	require.True(t, modelFound)
+	assert.Equal(t, "model 'Person' was deprecated (since 3.0)", modelChange.Reason)
+
+	undeprecatedReport := registrydiff.CheckCompatibility(after, before)
+	undeprecatedChange, undeprecatedFound := findClassifiedChange(undeprecatedReport, "Nationality", "FR", "entry")
+	require.True(t, undeprecatedFound)
+	assert.Equal(t, "entry 'FR' is no longer deprecated", undeprecatedChange.Reason)
+}
+
+func TestCheckCompatibility_EnumKindAndOrder(t *testing.T) {
+	before := registry.NewRegistry()
+	before.SetEnum("Level", yaml.Enum{Name: "Level", Type: yaml.EnumTypeInteger, Kind: yaml.EnumKindOrdered, Entries: map[string]any{"Low": 1, "High": 2}, EntryOrder: []string{"Low", "High"}})
+	before.SetEnum("Access", yaml.Enum{Name: "Access", Type: yaml.EnumTypeInteger, Entries: map[string]any{"Read": 1, "Write": 2}})
+	after := registry.NewRegistry()
+	after.SetEnum("Level", yaml.Enum{Name: "Level", Type: yaml.EnumTypeInteger, Kind: yaml.EnumKindOrdered, Entries: map[string]any{"Low": 1, "High": 2}, EntryOrder: []string{"High", "Low"}})
+	after.SetEnum("Access", yaml.Enum{Name: "Access", Type: yaml.EnumTypeInteger, Kind: yaml.EnumKindFlag, Entries: map[string]any{"Read": 1, "Write": 2}})
+
+	report := registrydiff.CheckCompatibility(before, after)
+
+	assert.True(t, report.IsBreaking())
+	orderChange, orderFound := findClassifiedChange(report, "Level", "entries", "order")
+	require.True(t, orderFound)
+	assert.Equal(t, registrydiff.CompatibilityBreaking, orderChange.Compatibility)
+	assert.Equal(t, "ordered enum entries were reordered from [Low High] to [High Low]", orderChange.Reason)
+	kindChange, kindFound := findClassifiedChange(report, "Access", "kind", "kind")
+	require.True(t, kindFound)
+	assert.Equal(t, registrydiff.CompatibilityBreaking, kindChange.Compatibility)
+	assert.Equal(t, "enum kind changed from '' to 'Flag'", kindChange.Reason)
+}
@@ -0,0 +1,32 @@
+package registrydiff
+
+import "github.com/kalo-build/morphe-go/pkg/registry"
+
+// DefinitionKind is the kind of a registry definition
+type DefinitionKind = registry.DefinitionKind
+
+const (
+	DefinitionKindEnum      = registry.DefinitionKindEnum
+	DefinitionKindModel     = registry.DefinitionKindModel
+	DefinitionKindStructure = registry.DefinitionKindStructure
+	DefinitionKindEntity    = registry.DefinitionKindEntity
+)
+
+// ChangeType describes whether something was added, removed or changed
+type ChangeType string
+
+const (
+	ChangeTypeAdded   ChangeType = "added"
+	ChangeTypeRemoved ChangeType = "removed"
+	ChangeTypeChanged ChangeType = "changed"
+)
+
+// DefinitionDiff is the difference of a single named definition between two registries
+type DefinitionDiff struct {
+	Kind   DefinitionKind `json:"kind"`
+	Name   string         `json:"name"`
+	Change ChangeType     `json:"change"`
+
+	// Members holds the individual member changes of a changed definition
+	Members []MemberChange `json:"members,omitempty"`
+}
@@ -0,0 +1,34 @@
+package registrydiff
+
+// MemberKind is the kind of a definition member
+type MemberKind string
+
+const (
+	MemberKindEnumType   MemberKind = "enumType"
+	MemberKindEnumKind   MemberKind = "enumKind"
+	MemberKindEnumEntry  MemberKind = "enumEntry"
+	MemberKindEnumOrder  MemberKind = "enumOrder"
+	MemberKindField      MemberKind = "field"
+	MemberKindAttribute  MemberKind = "attribute"
+	MemberKindIdentifier MemberKind = "identifier"
+	MemberKindRelation   MemberKind = "relation"
+
+	MemberKindDeprecation MemberKind = "deprecation"
+)
+
+// MemberChange is a single change to a member of a definition
+type MemberChange struct {
+	Member MemberKind `json:"member"`
+	Name   string     `json:"name"`
+	Change ChangeType `json:"change"`
+
+	// Field is the owning field name of an attribute change
+	Field string `json:"field,omitempty"`
+
+	// Property is the changed property of a changed member, such as 'type' or 'aliased',
+	// or the kind of the deprecated item of a deprecation change, such as 'field' or 'entry'
+	Property string `json:"property,omitempty"`
+
+	Before any `json:"before,omitempty"`
+	After  any `json:"after,omitempty"`
+}
@@ -0,0 +1,52 @@
+package registrydiff
+
+import (
+	"encoding/json"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+// RegistryDiff is the structured difference between two registries, grouped by definition kind and sorted by name
+type RegistryDiff struct {
+	Enums      []DefinitionDiff `json:"enums"`
+	Models     []DefinitionDiff `json:"models"`
+	Structures []DefinitionDiff `json:"structures"`
+	Entities   []DefinitionDiff `json:"entities"`
+}
+
+// Compare computes the difference between the definitions of the before and after registries
+func Compare(before *registry.Registry, after *registry.Registry) RegistryDiff {
+	if before == nil {
+		before = registry.NewRegistry()
+	}
+	if after == nil {
+		after = registry.NewRegistry()
+	}
+
+	return RegistryDiff{
+		Enums:      CompareEnums(before.GetAllEnums(), after.GetAllEnums()),
+		Models:     CompareModels(before.GetAllModels(), after.GetAllModels()),
+		Structures: CompareStructures(before.GetAllStructures(), after.GetAllStructures()),
+		Entities:   CompareEntities(before.GetAllEntities(), after.GetAllEntities()),
+	}
+}
+
+// IsEmpty returns true if the registries have no differences
+func (d RegistryDiff) IsEmpty() bool {
+	return len(d.Enums) == 0 && len(d.Models) == 0 && len(d.Structures) == 0 && len(d.Entities) == 0
+}
+
+// GetAll returns all definition diffs in kind order: enums, models, structures, entities
+func (d RegistryDiff) GetAll() []DefinitionDiff {
+	all := make([]DefinitionDiff, 0, len(d.Enums)+len(d.Models)+len(d.Structures)+len(d.Entities))
+	all = append(all, d.Enums...)
+	all = append(all, d.Models...)
+	all = append(all, d.Structures...)
+	all = append(all, d.Entities...)
+	return all
+}
+
+// ToJSON serialises the diff as indented JSON
+func (d RegistryDiff) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
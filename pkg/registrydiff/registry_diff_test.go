@@ -0,0 +1,235 @@
+package registrydiff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/registrydiff"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+func getBeforeRegistry() *registry.Registry {
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", yaml.Enum{
+		Name:    "Nationality",
+		Type:    yaml.EnumTypeString,
+		Entries: map[string]any{"US": "American", "DE": "German", "FR": "French"},
+	})
+	r.SetEnum("Legacy", yaml.Enum{
+		Name:    "Legacy",
+		Type:    yaml.EnumTypeInteger,
+		Entries: map[string]any{"A": 1},
+	})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":        {Type: yaml.ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+			"FirstName": {Type: yaml.ModelFieldTypeString},
+			"Age":       {Type: yaml.ModelFieldTypeInteger},
+			"Nickname":  {Type: yaml.ModelFieldTypeString},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+			"name":    {Fields: []string{"FirstName"}},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Company": {Type: "ForOne"},
+		},
+	})
+	r.SetStructure("Address", yaml.Structure{
+		Name: "Address",
+		Fields: map[string]yaml.StructureField{
+			"Street": {Type: yaml.StructureFieldTypeString},
+		},
+	})
+	r.SetEntity("Person", yaml.Entity{
+		Name: "Person",
+		Fields: map[string]yaml.EntityField{
+			"ID":   {Type: "Person.ID"},
+			"Name": {Type: "Person.FirstName"},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	})
+	return r
+}
+
+func getAfterRegistry() *registry.Registry {
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", yaml.Enum{
+		Name:    "Nationality",
+		Type:    yaml.EnumTypeString,
+		Entries: map[string]any{"US": "American", "DE": "Deutsch", "IT": "Italian"},
+	})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID":        {Type: yaml.ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory", "immutable"}},
+			"FirstName": {Type: yaml.ModelFieldTypeString},
+			"Age":       {Type: yaml.ModelFieldTypeFloat},
+			"Email":     {Type: yaml.ModelFieldTypeString},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+			"name":    {Fields: []string{"FirstName", "Age"}},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Company": {Type: "ForOne", Required: true, OnDelete: yaml.ModelRelationOnDeleteCascade},
+			"Pets":    {Type: "HasMany"},
+		},
+	})
+	r.SetModel("Company", yaml.Model{
+		Name: "Company",
+		Fields: map[string]yaml.ModelField{
+			"ID": {Type: yaml.ModelFieldTypeAutoIncrement},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	})
+	r.SetStructure("Address", yaml.Structure{
+		Name: "Address",
+		Fields: map[string]yaml.StructureField{
+			"Street": {Type: yaml.StructureFieldTypeString},
+		},
+	})
+	r.SetEntity("Person", yaml.Entity{
+		Name: "Person",
+		Fields: map[string]yaml.EntityField{
+			"ID":   {Type: "Person.ID"},
+			"Name": {Type: "Person.Nickname"},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	})
+	return r
+}
+
+func TestCompare_NoChanges(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getBeforeRegistry())
+
+	assert.True(t, diff.IsEmpty())
+	assert.Empty(t, diff.GetAll())
+}
+
+func TestCompare_Enums(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry())
+
+	require.Len(t, diff.Enums, 2)
+	assert.Equal(t, registrydiff.DefinitionDiff{
+		Kind:   registrydiff.DefinitionKindEnum,
+		Name:   "Legacy",
+		Change: registrydiff.ChangeTypeRemoved,
+	}, diff.Enums[0])
+
+	nationalityDiff := diff.Enums[1]
+	assert.Equal(t, "Nationality", nationalityDiff.Name)
+	assert.Equal(t, registrydiff.ChangeTypeChanged, nationalityDiff.Change)
+	assert.Equal(t, []registrydiff.MemberChange{
+		{Member: registrydiff.MemberKindEnumEntry, Name: "DE", Change: registrydiff.ChangeTypeChanged, Property: "value", Before: "German", After: "Deutsch"},
+		{Member: registrydiff.MemberKindEnumEntry, Name: "FR", Change: registrydiff.ChangeTypeRemoved, Before: "French"},
+		{Member: registrydiff.MemberKindEnumEntry, Name: "IT", Change: registrydiff.ChangeTypeAdded, After: "Italian"},
+	}, nationalityDiff.Members)
+}
+
+func TestCompare_Models(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry())
+
+	require.Len(t, diff.Models, 2)
+	assert.Equal(t, registrydiff.DefinitionDiff{
+		Kind:   registrydiff.DefinitionKindModel,
+		Name:   "Company",
+		Change: registrydiff.ChangeTypeAdded,
+	}, diff.Models[0])
+
+	personDiff := diff.Models[1]
+	assert.Equal(t, "Person", personDiff.Name)
+	assert.Equal(t, registrydiff.ChangeTypeChanged, personDiff.Change)
+	assert.Equal(t, []registrydiff.MemberChange{
+		{Member: registrydiff.MemberKindField, Name: "Age", Change: registrydiff.ChangeTypeChanged, Property: "type", Before: "Integer", After: "Float"},
+		{Member: registrydiff.MemberKindField, Name: "Email", Change: registrydiff.ChangeTypeAdded, After: "String"},
+		{Member: registrydiff.MemberKindAttribute, Name: "immutable", Field: "ID", Change: registrydiff.ChangeTypeAdded},
+		{Member: registrydiff.MemberKindField, Name: "Nickname", Change: registrydiff.ChangeTypeRemoved, Before: "String"},
+		{Member: registrydiff.MemberKindIdentifier, Name: "name", Change: registrydiff.ChangeTypeChanged, Property: "fields", Before: []string{"FirstName"}, After: []string{"FirstName", "Age"}},
+		{Member: registrydiff.MemberKindRelation, Name: "Company", Change: registrydiff.ChangeTypeChanged, Property: "required", Before: false, After: true},
+		{Member: registrydiff.MemberKindRelation, Name: "Company", Change: registrydiff.ChangeTypeChanged, Property: "onDelete", Before: "", After: "cascade"},
+		{Member: registrydiff.MemberKindRelation, Name: "Pets", Change: registrydiff.ChangeTypeAdded, After: "HasMany"},
+	}, personDiff.Members)
+}
+
+func TestCompare_StructuresUnchanged(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry())
+
+	assert.Empty(t, diff.Structures)
+}
+
+func TestCompare_Entities(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry())
+
+	require.Len(t, diff.Entities, 1)
+	assert.Equal(t, []registrydiff.MemberChange{
+		{Member: registrydiff.MemberKindField, Name: "Name", Change: registrydiff.ChangeTypeChanged, Property: "type", Before: "Person.FirstName", After: "Person.Nickname"},
+	}, diff.Entities[0].Members)
+}
+
+func TestCompare_NilRegistries(t *testing.T) {
+	diff := registrydiff.Compare(nil, getBeforeRegistry())
+
+	assert.Len(t, diff.Enums, 2)
+	assert.Len(t, diff.Models, 1)
+	assert.Len(t, diff.Structures, 1)
+	assert.Len(t, diff.Entities, 1)
+	for _, definitionDiff := range diff.GetAll() {
+		assert.Equal(t, registrydiff.ChangeTypeAdded, definitionDiff.Change)
+	}
+}
+
+func TestRegistryDiff_ToJSON(t *testing.T) {
+	diff := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry())
+
+	diffJSON, jsonErr := diff.ToJSON()
+	require.NoError(t, jsonErr)
+
+	// Serialisation is deterministic across repeated comparisons
+	repeatedJSON, repeatedErr := registrydiff.Compare(getBeforeRegistry(), getAfterRegistry()).ToJSON()
+	require.NoError(t, repeatedErr)
+	assert.Equal(t, string(diffJSON), string(repeatedJSON))
+
+	var decoded map[string][]map[string]any
+	require.NoError(t, json.Unmarshal(diffJSON, &decoded))
+	assert.Equal(t, "Legacy", decoded["enums"][0]["name"])
+	assert.Equal(t, "removed", decoded["enums"][0]["change"])
+	assert.Equal(t, "Company", decoded["models"][0]["name"])
+}
+
+func TestCompareEnums_KindAndOrder(t *testing.T) {
+	before := map[string]yaml.Enum{
+		"Level":  {Name: "Level", Type: yaml.EnumTypeString, Kind: yaml.EnumKindOrdered, Entries: map[string]any{"Low": "low", "Mid": "mid", "High": "high"}, EntryOrder: []string{"Low", "Mid", "High"}},
+		"Status": {Name: "Status", Type: yaml.EnumTypeString, Entries: map[string]any{"On": "on", "Off": "off"}, EntryOrder: []string{"On", "Off"}},
+		"Access": {Name: "Access", Type: yaml.EnumTypeInteger, Entries: map[string]any{"Read": 1, "Write": 2}},
+	}
+	after := map[string]yaml.Enum{
+		"Level":  {Name: "Level", Type: yaml.EnumTypeString, Kind: yaml.EnumKindOrdered, Entries: map[string]any{"Low": "low", "High": "high", "Mid": "mid", "Max": "max"}, EntryOrder: []string{"Low", "High", "Max", "Mid"}},
+		"Status": {Name: "Status", Type: yaml.EnumTypeString, Entries: map[string]any{"On": "on", "Off": "off"}, EntryOrder: []string{"Off", "On"}},
+		"Access": {Name: "Access", Type: yaml.EnumTypeInteger, Kind: yaml.EnumKindFlag, Entries: map[string]any{"Read": 1, "Write": 2}},
+	}
+
+	diffs := registrydiff.CompareEnums(before, after)
+
+	require.Len(t, diffs, 2)
+	assert.Equal(t, "Access", diffs[0].Name)
+	assert.Equal(t, []registrydiff.MemberChange{
+		{Member: registrydiff.MemberKindEnumKind, Name: "kind", Change: registrydiff.ChangeTypeChanged, Property: "kind", Before: "", After: "Flag"},
+	}, diffs[0].Members)
+	assert.Equal(t, "Level", diffs[1].Name)
+	assert.Equal(t, []registrydiff.MemberChange{
+		{Member: registrydiff.MemberKindEnumEntry, Name: "Max", Change: registrydiff.ChangeTypeAdded, After: "max"},
+		{Member: registrydiff.MemberKindEnumOrder, Name: "entries", Change: registrydiff.ChangeTypeChanged, Property: "order", Before: []string{"Low", "Mid", "High"}, After: []string{"Low", "High", "Mid"}},
+	}, diffs[1].Members)
+}
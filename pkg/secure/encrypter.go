@@ -0,0 +1,70 @@
+package secure
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+)
+
+// Encrypter encrypts Sealed field values, which can be recovered with the same key
+type Encrypter interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// AESGCMCiphertextPrefix marks ciphertexts produced by the AESGCMEncrypter
+const AESGCMCiphertextPrefix = "aes-gcm$"
+
+// AESGCMEncrypter encrypts with AES-GCM and a random nonce per value
+type AESGCMEncrypter struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMEncrypter creates an encrypter from a 16, 24 or 32 byte key
+func NewAESGCMEncrypter(key []byte) (*AESGCMEncrypter, error) {
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return nil, ErrInvalidKeyLength(len(key))
+	}
+	block, blockErr := aes.NewCipher(key)
+	if blockErr != nil {
+		return nil, blockErr
+	}
+	aead, aeadErr := cipher.NewGCM(block)
+	if aeadErr != nil {
+		return nil, aeadErr
+	}
+	return &AESGCMEncrypter{aead: aead}, nil
+}
+
+// Encrypt returns 'aes-gcm$<base64 nonce and ciphertext>'
+func (e *AESGCMEncrypter) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, readErr := rand.Read(nonce); readErr != nil {
+		return "", readErr
+	}
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return AESGCMCiphertextPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (e *AESGCMEncrypter) Decrypt(ciphertext string) (string, error) {
+	if !e.IsEncrypted(ciphertext) {
+		return "", ErrMalformedCiphertext
+	}
+	sealed, decodeErr := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(ciphertext, AESGCMCiphertextPrefix))
+	if decodeErr != nil || len(sealed) < e.aead.NonceSize() {
+		return "", ErrMalformedCiphertext
+	}
+	nonceSize := e.aead.NonceSize()
+	plaintext, openErr := e.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if openErr != nil {
+		return "", openErr
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted checks whether a value has the ciphertext prefix, it does not prove the value was encrypted with this key
+func (e *AESGCMEncrypter) IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, AESGCMCiphertextPrefix)
+}
@@ -0,0 +1,194 @@
+package secure
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// MaskValue replaces Protected and Sealed values in masked records
+const MaskValue = "********"
+
+// FieldProtector applies the Protected and Sealed field types of the registry definitions to records.
+// Protected values are hashed, Sealed values are encrypted, and both are masked for logging and export.
+// Records are referenced by definition kind and name, since a model and an entity often share a name.
+type FieldProtector struct {
+	hasher    Hasher
+	encrypter Encrypter
+
+	models     map[string]yaml.Model
+	enums      map[string]yaml.Enum
+	structures map[string]yaml.Structure
+	entities   map[string]yaml.Entity
+}
+
+// fieldTransform replaces a string value of a Protected or Sealed field
+type fieldTransform func(fieldType yaml.ModelFieldType, value string) (string, error)
+
+// NewFieldProtector creates a field protector from a snapshot of the registry definitions
+func NewFieldProtector(r *registry.Registry, hasher Hasher, encrypter Encrypter) *FieldProtector {
+	return &FieldProtector{
+		hasher:     hasher,
+		encrypter:  encrypter,
+		models:     r.GetAllModels(),
+		enums:      r.GetAllEnums(),
+		structures: r.GetAllStructures(),
+		entities:   r.GetAllEntities(),
+	}
+}
+
+// Seal returns a copy of the plain record with Protected values hashed and Sealed values encrypted.
+// Every value is hashed or encrypted, whatever it looks like, so sealing an already sealed record seals it twice.
+func (p *FieldProtector) Seal(definition registry.DefinitionRef, record map[string]any) (map[string]any, error) {
+	return p.transformRecord(definition, record, func(fieldType yaml.ModelFieldType, value string) (string, error) {
+		if fieldType == yaml.ModelFieldTypeProtected {
+			return p.hasher.Hash(value)
+		}
+		return p.encrypter.Encrypt(value)
+	})
+}
+
+// SealChanges returns a copy of an already sealed record with the plain changes sealed and applied, the other fields are kept as they are
+func (p *FieldProtector) SealChanges(definition registry.DefinitionRef, sealed map[string]any, changes map[string]any) (map[string]any, error) {
+	sealedChanges, sealErr := p.Seal(definition, changes)
+	if sealErr != nil {
+		return nil, sealErr
+	}
+	updated := make(map[string]any, len(sealed)+len(sealedChanges))
+	for fieldName, value := range sealed {
+		updated[fieldName] = value
+	}
+	for fieldName, value := range sealedChanges {
+		updated[fieldName] = value
+	}
+	return updated, nil
+}
+
+// Unseal returns a copy of the sealed record with Sealed values decrypted, Protected values stay hashed
+func (p *FieldProtector) Unseal(definition registry.DefinitionRef, record map[string]any) (map[string]any, error) {
+	return p.transformRecord(definition, record, func(fieldType yaml.ModelFieldType, value string) (string, error) {
+		if fieldType == yaml.ModelFieldTypeProtected {
+			return value, nil
+		}
+		return p.encrypter.Decrypt(value)
+	})
+}
+
+// Mask returns a copy of the record with all Protected and Sealed values replaced by MaskValue
+func (p *FieldProtector) Mask(definition registry.DefinitionRef, record map[string]any) (map[string]any, error) {
+	return p.transformRecord(definition, record, func(yaml.ModelFieldType, string) (string, error) {
+		return MaskValue, nil
+	})
+}
+
+// VerifyProtected checks a plain value against the hashed value of a top-level Protected field
+func (p *FieldProtector) VerifyProtected(definition registry.DefinitionRef, record map[string]any, fieldName string, value string) (bool, error) {
+	fieldTypes, typesErr := p.getFieldTypes(definition)
+	if typesErr != nil {
+		return false, typesErr
+	}
+	if fieldTypes[fieldName] != string(yaml.ModelFieldTypeProtected) {
+		return false, ErrNotProtectedField(definition.Name, fieldName)
+	}
+
+	hash, isString := record[fieldName].(string)
+	if !isString {
+		return false, nil
+	}
+	return p.hasher.Verify(hash, value)
+}
+
+func (p *FieldProtector) transformRecord(definition registry.DefinitionRef, record map[string]any, transform fieldTransform) (map[string]any, error) {
+	fieldTypes, typesErr := p.getFieldTypes(definition)
+	if typesErr != nil {
+		return nil, typesErr
+	}
+	return p.transformFields("", fieldTypes, record, transform)
+}
+
+func (p *FieldProtector) transformFields(pathPrefix string, fieldTypes map[string]string, record map[string]any, transform fieldTransform) (map[string]any, error) {
+	transformed := make(map[string]any, len(record))
+	for _, fieldName := range core.MapKeysSorted(record) {
+		value := record[fieldName]
+		fieldType := fieldTypes[fieldName]
+		fieldPath := pathPrefix + fieldName
+		transformed[fieldName] = value
+		if value == nil {
+			continue
+		}
+
+		if _, isStructure := p.structures[fieldType]; isStructure {
+			nestedRecord, isMap := value.(map[string]any)
+			if !isMap {
+				continue
+			}
+			nestedTransformed, nestedErr := p.transformFields(fieldPath+".", p.getStructureFieldTypes(fieldType), nestedRecord, transform)
+			if nestedErr != nil {
+				return nil, nestedErr
+			}
+			transformed[fieldName] = nestedTransformed
+			continue
+		}
+
+		if fieldType != string(yaml.ModelFieldTypeProtected) && fieldType != string(yaml.ModelFieldTypeSealed) {
+			continue
+		}
+		stringValue, isString := value.(string)
+		if !isString {
+			return nil, ErrNonStringValue(fieldPath, fieldType, value)
+		}
+		transformedValue, transformErr := transform(yaml.ModelFieldType(fieldType), stringValue)
+		if transformErr != nil {
+			return nil, ErrFieldValue(fieldPath, transformErr)
+		}
+		transformed[fieldName] = transformedValue
+	}
+	return transformed, nil
+}
+
+// getFieldTypes returns the type of each field of the referenced model, entity or structure
+func (p *FieldProtector) getFieldTypes(definition registry.DefinitionRef) (map[string]string, error) {
+	switch definition.Kind {
+	case registry.DefinitionKindModel:
+		model, isModel := p.models[definition.Name]
+		if !isModel {
+			return nil, ErrUnknownDefinition(definition)
+		}
+		fieldTypes := make(map[string]string, len(model.Fields))
+		for fieldName, field := range model.Fields {
+			fieldTypes[fieldName] = string(field.Type)
+		}
+		return fieldTypes, nil
+
+	case registry.DefinitionKindEntity:
+		entity, isEntity := p.entities[definition.Name]
+		if !isEntity {
+			return nil, ErrUnknownDefinition(definition)
+		}
+		fieldTypes := make(map[string]string, len(entity.Fields))
+		for _, fieldName := range core.MapKeysSorted(entity.Fields) {
+			resolved, resolveErr := entity.ResolveFieldExpression(fieldName, p.models, p.enums, p.structures)
+			if resolveErr != nil {
+				return nil, resolveErr
+			}
+			fieldTypes[fieldName] = string(resolved.Type)
+		}
+		return fieldTypes, nil
+
+	case registry.DefinitionKindStructure:
+		if _, isStructure := p.structures[definition.Name]; !isStructure {
+			return nil, ErrUnknownDefinition(definition)
+		}
+		return p.getStructureFieldTypes(definition.Name), nil
+	}
+	return nil, ErrUnsupportedDefinitionKind(definition.Kind)
+}
+
+func (p *FieldProtector) getStructureFieldTypes(structureName string) map[string]string {
+	structure := p.structures[structureName]
+	fieldTypes := make(map[string]string, len(structure.Fields))
+	for fieldName, field := range structure.Fields {
+		fieldTypes[fieldName] = string(field.Type)
+	}
+	return fieldTypes
+}
@@ -0,0 +1,174 @@
+package secure_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/morphe-go/internal/testutils"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/secure"
+)
+
+type FieldProtectorTestSuite struct {
+	suite.Suite
+
+	Registry *registry.Registry
+}
+
+func TestFieldProtectorTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldProtectorTestSuite))
+}
+
+func (suite *FieldProtectorTestSuite) SetupTest() {
+	r, loadErr := registry.LoadMorpheRegistry(registry.LoadMorpheRegistryHooks{}, testutils.GetRegistryConfig("records"))
+	suite.Require().NoError(loadErr)
+	suite.Registry = r
+}
+
+func (suite *FieldProtectorTestSuite) TearDownTest() {
+	suite.Registry = nil
+}
+
+func (suite *FieldProtectorTestSuite) getFieldProtector() *secure.FieldProtector {
+	encrypter, encrypterErr := secure.NewAESGCMEncrypter([]byte("0123456789abcdef0123456789abcdef"))
+	suite.Require().NoError(encrypterErr)
+	return secure.NewFieldProtector(suite.Registry, secure.PBKDF2Hasher{Iterations: 10}, encrypter)
+}
+
+var (
+	personModel     = registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "Person"}
+	personEntity    = registry.DefinitionRef{Kind: registry.DefinitionKindEntity, Name: "Person"}
+	credentialsType = registry.DefinitionRef{Kind: registry.DefinitionKindStructure, Name: "Credentials"}
+)
+
+func getPersonRecord() map[string]any {
+	return map[string]any{
+		"ID":          1,
+		"FirstName":   "Ada",
+		"Password":    "hunter2",
+		"SSN":         "123-45-6789",
+		"Credentials": map[string]any{"Username": "ada", "Token": "abc"},
+	}
+}
+
+func (suite *FieldProtectorTestSuite) TestSealAndUnseal() {
+	protector := suite.getFieldProtector()
+	record := getPersonRecord()
+
+	sealed, sealErr := protector.Seal(personModel, record)
+	suite.Require().NoError(sealErr)
+	unsealed, unsealErr := protector.Unseal(personModel, sealed)
+	suite.Require().NoError(unsealErr)
+
+	suite.Equal("hunter2", record["Password"])
+	suite.Contains(sealed["Password"], secure.PBKDF2HashPrefix)
+	suite.Contains(sealed["SSN"], secure.AESGCMCiphertextPrefix)
+	suite.Contains(sealed["Credentials"].(map[string]any)["Token"], secure.AESGCMCiphertextPrefix)
+	suite.Equal("Ada", sealed["FirstName"])
+
+	suite.Equal(sealed["Password"], unsealed["Password"])
+	suite.Equal("123-45-6789", unsealed["SSN"])
+	suite.Equal(map[string]any{"Username": "ada", "Token": "abc"}, unsealed["Credentials"])
+}
+
+func (suite *FieldProtectorTestSuite) TestSeal_PrefixedValues() {
+	protector := suite.getFieldProtector()
+	knownHash, hashErr := secure.PBKDF2Hasher{Iterations: 10}.Hash("attacker")
+	suite.Require().NoError(hashErr)
+	plaintext := secure.AESGCMCiphertextPrefix + "plaintext"
+
+	sealed, sealErr := protector.Seal(personModel, map[string]any{"Password": knownHash, "SSN": plaintext})
+	suite.Require().NoError(sealErr)
+	attackerValid, attackerErr := protector.VerifyProtected(personModel, sealed, "Password", "attacker")
+	hashValid, hashValidErr := protector.VerifyProtected(personModel, sealed, "Password", knownHash)
+	unsealed, unsealErr := protector.Unseal(personModel, sealed)
+	_, plaintextErr := protector.Unseal(personModel, map[string]any{"SSN": "123-45-6789"})
+
+	suite.Require().NoError(attackerErr)
+	suite.Require().NoError(hashValidErr)
+	suite.Require().NoError(unsealErr)
+	suite.NotEqual(knownHash, sealed["Password"])
+	suite.False(attackerValid)
+	suite.True(hashValid)
+	suite.Equal(plaintext, unsealed["SSN"])
+	suite.ErrorContains(plaintextErr, "field 'SSN': malformed ciphertext")
+}
+
+func (suite *FieldProtectorTestSuite) TestSealChanges() {
+	protector := suite.getFieldProtector()
+	sealed, sealErr := protector.Seal(personModel, getPersonRecord())
+	suite.Require().NoError(sealErr)
+
+	updated, updateErr := protector.SealChanges(personModel, sealed, map[string]any{"SSN": "987-65-4321", "FirstName": "Augusta"})
+	suite.Require().NoError(updateErr)
+	unsealed, unsealErr := protector.Unseal(personModel, updated)
+	suite.Require().NoError(unsealErr)
+	valid, validErr := protector.VerifyProtected(personModel, updated, "Password", "hunter2")
+	suite.Require().NoError(validErr)
+
+	suite.Equal(sealed["Password"], updated["Password"])
+	suite.Equal(sealed["Credentials"], updated["Credentials"])
+	suite.Equal("Augusta", updated["FirstName"])
+	suite.Equal("987-65-4321", unsealed["SSN"])
+	suite.Equal("123-45-6789", getPersonRecord()["SSN"])
+	suite.True(valid)
+}
+
+func (suite *FieldProtectorTestSuite) TestVerifyProtected() {
+	protector := suite.getFieldProtector()
+	sealed, sealErr := protector.Seal(personModel, getPersonRecord())
+	suite.Require().NoError(sealErr)
+
+	valid, validErr := protector.VerifyProtected(personModel, sealed, "Password", "hunter2")
+	invalid, invalidErr := protector.VerifyProtected(personModel, sealed, "Password", "wrong")
+	_, notProtectedErr := protector.VerifyProtected(personModel, sealed, "SSN", "123-45-6789")
+
+	suite.Require().NoError(validErr)
+	suite.Require().NoError(invalidErr)
+	suite.True(valid)
+	suite.False(invalid)
+	suite.ErrorContains(notProtectedErr, "'Person' field 'SSN' is not a Protected field")
+}
+
+func (suite *FieldProtectorTestSuite) TestMask() {
+	protector := suite.getFieldProtector()
+
+	masked, maskErr := protector.Mask(personModel, getPersonRecord())
+	maskedEntity, entityErr := protector.Mask(personEntity, map[string]any{"ID": 1, "SSN": "123-45-6789", "TaxID": "123-45-6789"})
+	maskedStructure, structureErr := protector.Mask(credentialsType, map[string]any{"Token": "abc"})
+
+	suite.Require().NoError(maskErr)
+	suite.Require().NoError(entityErr)
+	suite.Require().NoError(structureErr)
+	suite.Equal(map[string]any{
+		"ID":          1,
+		"FirstName":   "Ada",
+		"Password":    secure.MaskValue,
+		"SSN":         secure.MaskValue,
+		"Credentials": map[string]any{"Username": "ada", "Token": secure.MaskValue},
+	}, masked)
+	suite.Equal(secure.MaskValue, maskedEntity["SSN"])
+	suite.Equal(secure.MaskValue, maskedEntity["TaxID"])
+	suite.Equal(secure.MaskValue, maskedStructure["Token"])
+}
+
+func (suite *FieldProtectorTestSuite) TestFieldProtector_Errors() {
+	protector := suite.getFieldProtector()
+
+	_, unknownErr := protector.Seal(registry.DefinitionRef{Kind: registry.DefinitionKindModel, Name: "Unknown"}, getPersonRecord())
+	_, kindErr := protector.Seal(registry.DefinitionRef{Kind: registry.DefinitionKindEnum, Name: "Nationality"}, getPersonRecord())
+	_, nonStringErr := protector.Seal(personModel, map[string]any{"Password": 1234})
+	_, tamperedErr := protector.Unseal(personModel, map[string]any{"SSN": secure.AESGCMCiphertextPrefix + "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"})
+
+	suite.ErrorContains(unknownErr, "no model with name 'Unknown' found in registry")
+	suite.ErrorContains(kindErr, "definition kind 'enum' has no record fields, expected a model, entity or structure")
+	suite.ErrorContains(nonStringErr, "field 'Password' of type 'Protected' must be a string, got int")
+	suite.ErrorContains(tamperedErr, "field 'SSN': ")
+}
+
+func (suite *FieldProtectorTestSuite) TestNewAESGCMEncrypter_InvalidKey() {
+	_, keyErr := secure.NewAESGCMEncrypter([]byte("short"))
+
+	suite.ErrorContains(keyErr, "invalid AES key length 5, expected 16, 24 or 32 bytes")
+}
@@ -0,0 +1,107 @@
+package secure
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Hasher hashes Protected field values, which can be verified but never recovered
+type Hasher interface {
+	Hash(value string) (string, error)
+	Verify(hash string, value string) (bool, error)
+}
+
+// PBKDF2HashPrefix marks hashes produced by the PBKDF2Hasher
+const PBKDF2HashPrefix = "pbkdf2-sha256$"
+
+// DefaultPBKDF2Iterations is the iteration count used when none is configured
+const DefaultPBKDF2Iterations = 100000
+
+// MaxPBKDF2Iterations caps the iteration count, so a stored hash cannot make verification arbitrarily expensive
+const MaxPBKDF2Iterations = 1000000
+
+const pbkdf2SaltLength = 16
+const pbkdf2KeyLength = 32
+
+// PBKDF2Hasher is a salted PBKDF2-HMAC-SHA256 hasher built on the standard library
+type PBKDF2Hasher struct {
+	Iterations int
+}
+
+// NewPBKDF2Hasher creates a hasher with the default iteration count
+func NewPBKDF2Hasher() PBKDF2Hasher {
+	return PBKDF2Hasher{Iterations: DefaultPBKDF2Iterations}
+}
+
+// Hash returns 'pbkdf2-sha256$<iterations>$<salt>$<key>' with a random salt
+func (h PBKDF2Hasher) Hash(value string) (string, error) {
+	iterations := h.Iterations
+	if iterations <= 0 {
+		iterations = DefaultPBKDF2Iterations
+	}
+	if iterations > MaxPBKDF2Iterations {
+		return "", ErrPBKDF2IterationsExceeded(iterations)
+	}
+	salt := make([]byte, pbkdf2SaltLength)
+	if _, readErr := rand.Read(salt); readErr != nil {
+		return "", readErr
+	}
+	key := derivePBKDF2Key([]byte(value), salt, iterations)
+	return fmt.Sprintf("%s%d$%s$%s", PBKDF2HashPrefix, iterations,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify checks a value against a hash in constant time, hashes with more than MaxPBKDF2Iterations iterations are rejected
+func (h PBKDF2Hasher) Verify(hash string, value string) (bool, error) {
+	hashParts := strings.Split(strings.TrimPrefix(hash, PBKDF2HashPrefix), "$")
+	if !h.IsHash(hash) || len(hashParts) != 3 {
+		return false, ErrMalformedHash
+	}
+	iterations, iterationsErr := strconv.Atoi(hashParts[0])
+	salt, saltErr := base64.RawStdEncoding.DecodeString(hashParts[1])
+	expectedKey, keyErr := base64.RawStdEncoding.DecodeString(hashParts[2])
+	if iterationsErr != nil || saltErr != nil || keyErr != nil || iterations <= 0 {
+		return false, ErrMalformedHash
+	}
+	if iterations > MaxPBKDF2Iterations {
+		return false, ErrPBKDF2IterationsExceeded(iterations)
+	}
+
+	key := derivePBKDF2Key([]byte(value), salt, iterations)
+	return subtle.ConstantTimeCompare(key, expectedKey) == 1, nil
+}
+
+// IsHash checks whether a value has the hash prefix
+func (h PBKDF2Hasher) IsHash(value string) bool {
+	return strings.HasPrefix(value, PBKDF2HashPrefix)
+}
+
+// derivePBKDF2Key implements PBKDF2 (RFC 8018) for a single HMAC-SHA256 block, which covers the 32 byte key length
+func derivePBKDF2Key(password []byte, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha256.New, password)
+	blockIndex := make([]byte, 4)
+	binary.BigEndian.PutUint32(blockIndex, 1)
+
+	mac.Write(salt)
+	mac.Write(blockIndex)
+	block := mac.Sum(nil)
+
+	key := make([]byte, len(block))
+	copy(key, block)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(block)
+		block = mac.Sum(block[:0])
+		for j := range key {
+			key[j] ^= block[j]
+		}
+	}
+	return key[:pbkdf2KeyLength]
+}
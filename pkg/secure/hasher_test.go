@@ -0,0 +1,46 @@
+package secure
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivePBKDF2Key_KnownVectors(t *testing.T) {
+	assert.Equal(t, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b",
+		hex.EncodeToString(derivePBKDF2Key([]byte("password"), []byte("salt"), 1)))
+	assert.Equal(t, "ae4d0c95af6b46d32d0adff928f06dd02a303f8ef3c251dfd6e2d85a95474c43",
+		hex.EncodeToString(derivePBKDF2Key([]byte("password"), []byte("salt"), 2)))
+}
+
+func TestPBKDF2Hasher(t *testing.T) {
+	hasher := PBKDF2Hasher{Iterations: 10}
+
+	hash, hashErr := hasher.Hash("secret")
+	otherHash, otherErr := hasher.Hash("secret")
+	valid, validErr := hasher.Verify(hash, "secret")
+	invalid, invalidErr := hasher.Verify(hash, "wrong")
+	_, malformedErr := hasher.Verify(PBKDF2HashPrefix+"x$y", "secret")
+
+	require.NoError(t, hashErr)
+	require.NoError(t, otherErr)
+	require.NoError(t, validErr)
+	require.NoError(t, invalidErr)
+	assert.True(t, hasher.IsHash(hash))
+	assert.NotEqual(t, hash, otherHash)
+	assert.True(t, valid)
+	assert.False(t, invalid)
+	assert.ErrorIs(t, malformedErr, ErrMalformedHash)
+}
+
+func TestPBKDF2Hasher_MaxIterations(t *testing.T) {
+	hasher := PBKDF2Hasher{Iterations: 10}
+
+	_, verifyErr := hasher.Verify(PBKDF2HashPrefix+"2000000000$c2FsdA$a2V5", "secret")
+	_, hashErr := PBKDF2Hasher{Iterations: MaxPBKDF2Iterations + 1}.Hash("secret")
+
+	assert.ErrorContains(t, verifyErr, "PBKDF2 iteration count 2000000000 exceeds the maximum of 1000000")
+	assert.ErrorContains(t, hashErr, "PBKDF2 iteration count 1000001 exceeds the maximum of 1000000")
+}
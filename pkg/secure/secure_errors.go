@@ -0,0 +1,39 @@
+package secure
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/morphe-go/pkg/registry"
+)
+
+var ErrMalformedHash = fmt.Errorf("malformed hash")
+
+var ErrMalformedCiphertext = fmt.Errorf("malformed ciphertext")
+
+func ErrPBKDF2IterationsExceeded(iterations int) error {
+	return fmt.Errorf("PBKDF2 iteration count %d exceeds the maximum of %d", iterations, MaxPBKDF2Iterations)
+}
+
+func ErrInvalidKeyLength(length int) error {
+	return fmt.Errorf("invalid AES key length %d, expected 16, 24 or 32 bytes", length)
+}
+
+func ErrUnknownDefinition(definition registry.DefinitionRef) error {
+	return fmt.Errorf("no %s with name '%s' found in registry", definition.Kind, definition.Name)
+}
+
+func ErrUnsupportedDefinitionKind(kind registry.DefinitionKind) error {
+	return fmt.Errorf("definition kind '%s' has no record fields, expected a model, entity or structure", kind)
+}
+
+func ErrNonStringValue(fieldPath string, fieldType string, value any) error {
+	return fmt.Errorf("field '%s' of type '%s' must be a string, got %T", fieldPath, fieldType, value)
+}
+
+func ErrNotProtectedField(definitionName string, fieldName string) error {
+	return fmt.Errorf("'%s' field '%s' is not a Protected field", definitionName, fieldName)
+}
+
+func ErrFieldValue(fieldPath string, valueErr error) error {
+	return fmt.Errorf("field '%s': %w", fieldPath, valueErr)
+}
@@ -0,0 +1,64 @@
+package yaml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deprecation marks a definition or member as deprecated without removing it
+type Deprecation struct {
+	Since      string `yaml:"since,omitempty"`
+	Reason     string `yaml:"reason,omitempty"`
+	ReplacedBy string `yaml:"replacedBy,omitempty"`
+}
+
+// DeepClone returns a copy of the deprecation, or nil if not deprecated
+func (d *Deprecation) DeepClone() *Deprecation {
+	if d == nil {
+		return nil
+	}
+	deprecationCopy := *d
+	return &deprecationCopy
+}
+
+// Describe summarises the deprecation, such as 'since 2.3: use the contact email, replaced by Email'
+func (d Deprecation) Describe() string {
+	description := ""
+	if d.Since != "" {
+		description = "since " + d.Since
+	}
+	if d.Reason != "" {
+		description = joinDescription(description, ": ", d.Reason)
+	}
+	if d.ReplacedBy != "" {
+		description = joinDescription(description, ", ", "replaced by "+d.ReplacedBy)
+	}
+	return description
+}
+
+func joinDescription(description string, separator string, part string) string {
+	if description == "" {
+		return part
+	}
+	return description + separator + part
+}
+
+// Annotation renders the deprecation as a '@deprecated' doc annotation for generated code
+func (d Deprecation) Annotation() string {
+	description := d.Describe()
+	if description == "" {
+		return "@deprecated"
+	}
+	return fmt.Sprintf("@deprecated %s", description)
+}
+
+func normalizeDeprecation(d *Deprecation) *Deprecation {
+	if d == nil {
+		return nil
+	}
+	return &Deprecation{
+		Since:      strings.TrimSpace(d.Since),
+		Reason:     strings.TrimSpace(d.Reason),
+		ReplacedBy: strings.TrimSpace(d.ReplacedBy),
+	}
+}
@@ -0,0 +1,129 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDeprecation_Describe(t *testing.T) {
+	assert.Equal(t, "", Deprecation{}.Describe())
+	assert.Equal(t, "since 2.3", Deprecation{Since: "2.3"}.Describe())
+	assert.Equal(t, "use email", Deprecation{Reason: "use email"}.Describe())
+	assert.Equal(t, "since 2.3: use email, replaced by Email", Deprecation{Since: "2.3", Reason: "use email", ReplacedBy: "Email"}.Describe())
+}
+
+func TestDeprecation_Annotation(t *testing.T) {
+	assert.Equal(t, "@deprecated", Deprecation{}.Annotation())
+	assert.Equal(t, "@deprecated since 2.3, replaced by Email", Deprecation{Since: "2.3", ReplacedBy: "Email"}.Annotation())
+}
+
+func TestDeprecation_DeepClone(t *testing.T) {
+	var notDeprecated *Deprecation
+	assert.Nil(t, notDeprecated.DeepClone())
+
+	original := &Deprecation{Since: "2.3"}
+	cloned := original.DeepClone()
+	cloned.Since = "3.0"
+	assert.Equal(t, "2.3", original.Since)
+}
+
+func TestDeprecation_Unmarshal(t *testing.T) {
+	modelYAML := `
+name: Person
+deprecated:
+  since: " 2.3 "
+  reason: replaced by accounts
+fields:
+  Email:
+    type: String
+    deprecated:
+      replacedBy: ContactInfo.Email
+  Name:
+    type: String
+related:
+  Company:
+    type: ForOne
+    deprecated: {since: "2.4"}
+`
+	var model Model
+	require.NoError(t, yaml.Unmarshal([]byte(modelYAML), &model))
+	NormalizeModel(&model)
+
+	require.NotNil(t, model.Deprecated)
+	assert.Equal(t, Deprecation{Since: "2.3", Reason: "replaced by accounts"}, *model.Deprecated)
+	require.NotNil(t, model.Fields["Email"].Deprecated)
+	assert.Equal(t, "ContactInfo.Email", model.Fields["Email"].Deprecated.ReplacedBy)
+	assert.Nil(t, model.Fields["Name"].Deprecated)
+	require.NotNil(t, model.Related["Company"].Deprecated)
+	assert.Equal(t, "2.4", model.Related["Company"].Deprecated.Since)
+}
+
+func TestEnumValidate_DeprecatedEntries(t *testing.T) {
+	enum := Enum{
+		Name:              "Nationality",
+		Type:              EnumTypeString,
+		Entries:           map[string]any{"US": "American", "FR": "French"},
+		DeprecatedEntries: map[string]Deprecation{"FR": {Since: "2.0"}},
+	}
+	assert.NoError(t, enum.Validate())
+
+	enum.DeprecatedEntries["DE"] = Deprecation{}
+	assert.ErrorContains(t, enum.Validate(), "enum 'Nationality' deprecates unknown entry 'DE'")
+}
+
+func TestModelGetDeprecationWarnings(t *testing.T) {
+	allModels, allEnums, _ := fieldPathTestModels()
+	nationality := allEnums["Nationality"]
+	nationality.Deprecated = &Deprecation{Since: "2.0"}
+	allEnums["Nationality"] = nationality
+	company := allModels["Company"]
+	company.Deprecated = &Deprecation{Reason: "use Organisation"}
+	allModels["Company"] = company
+
+	warnings := allModels["Person"].GetDeprecationWarnings(allModels, allEnums)
+
+	require.Len(t, warnings, 2)
+	assert.Equal(t, "model 'Person' field 'Nationality' depends on deprecated enum 'Nationality' (since 2.0)", warnings[0].String())
+	assert.Equal(t, "model 'Person' relation 'Employer' depends on deprecated model 'Company' (use Organisation)", warnings[1].String())
+}
+
+func TestEntityGetDeprecationWarnings(t *testing.T) {
+	allModels, allEnums, _ := fieldPathTestModels()
+	person := allModels["Person"]
+	contactInfoRelation := person.Related["ContactInfo"]
+	contactInfoRelation.Deprecated = &Deprecation{Since: "2.1"}
+	person.Related["ContactInfo"] = contactInfoRelation
+	contactInfo := allModels["ContactInfo"]
+	emailField := contactInfo.Fields["Email"]
+	emailField.Deprecated = &Deprecation{ReplacedBy: "Person.Email"}
+	contactInfo.Fields["Email"] = emailField
+
+	allEntities := map[string]Entity{
+		"Account": {
+			Name:       "Account",
+			Fields:     map[string]EntityField{"ID": {Type: "Person.ID"}},
+			Deprecated: &Deprecation{},
+		},
+	}
+	entity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"Email": {Type: "Person.ContactInfo.Email"},
+			"Name":  {Type: "Person.Name"},
+		},
+		Related: map[string]EntityRelation{
+			"Account": {Type: "HasOne"},
+		},
+	}
+	allEntities["Person"] = entity
+
+	warnings := entity.GetDeprecationWarnings(allEntities, allModels, allEnums)
+
+	require.Len(t, warnings, 3)
+	assert.Equal(t, "entity 'Person' field 'Email' depends on deprecated model 'Person' relation 'ContactInfo' (since 2.1)", warnings[0].String())
+	assert.Equal(t, "entity 'Person' field 'Email' depends on deprecated model 'ContactInfo' field 'Email' (replaced by Person.Email)", warnings[1].String())
+	assert.Equal(t, "entity 'Person' relation 'Account' depends on deprecated entity 'Account'", warnings[2].String())
+}
@@ -0,0 +1,126 @@
+package yaml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+)
+
+// DeprecationWarning reports a definition member that depends on a deprecated item
+type DeprecationWarning struct {
+	// Source is the dependent member, such as "entity 'Person' field 'Email'"
+	Source string
+	// Target is the deprecated item, such as "model 'ContactInfo' field 'Email'"
+	Target      string
+	Deprecation Deprecation
+}
+
+func (w DeprecationWarning) String() string {
+	description := w.Deprecation.Describe()
+	if description == "" {
+		return fmt.Sprintf("%s depends on deprecated %s", w.Source, w.Target)
+	}
+	return fmt.Sprintf("%s depends on deprecated %s (%s)", w.Source, w.Target, description)
+}
+
+// deprecationWarnings collects the warnings of a single source, skipping repeated targets
+type deprecationWarnings struct {
+	source   string
+	targets  map[string]bool
+	warnings []DeprecationWarning
+}
+
+func newDeprecationWarnings(source string) *deprecationWarnings {
+	return &deprecationWarnings{
+		source:  source,
+		targets: map[string]bool{},
+	}
+}
+
+func (w *deprecationWarnings) add(target string, deprecation *Deprecation) {
+	if deprecation == nil || w.targets[target] {
+		return
+	}
+	w.targets[target] = true
+	w.warnings = append(w.warnings, DeprecationWarning{Source: w.source, Target: target, Deprecation: *deprecation})
+}
+
+// GetDeprecationWarnings reports fields typed with deprecated enums and relations into deprecated models
+func (m Model) GetDeprecationWarnings(allModels map[string]Model, allEnums map[string]Enum) []DeprecationWarning {
+	warnings := []DeprecationWarning{}
+	for _, fieldName := range core.MapKeysSorted(m.Fields) {
+		fieldWarnings := newDeprecationWarnings(fmt.Sprintf("model '%s' field '%s'", m.Name, fieldName))
+		enumName := string(m.Fields[fieldName].Type)
+		if enum, isEnum := allEnums[enumName]; isEnum {
+			fieldWarnings.add(fmt.Sprintf("enum '%s'", enumName), enum.Deprecated)
+		}
+		warnings = append(warnings, fieldWarnings.warnings...)
+	}
+
+	for _, relationName := range core.MapKeysSorted(m.Related) {
+		relation := m.Related[relationName]
+		relationWarnings := newDeprecationWarnings(fmt.Sprintf("model '%s' relation '%s'", m.Name, relationName))
+		for _, targetName := range getDeprecationRelationTargets(relationName, relation.Aliased, relation.For) {
+			if targetModel, exists := allModels[targetName]; exists {
+				relationWarnings.add(fmt.Sprintf("model '%s'", targetName), targetModel.Deprecated)
+			}
+		}
+		warnings = append(warnings, relationWarnings.warnings...)
+	}
+	return warnings
+}
+
+// GetDeprecationWarnings reports field paths that traverse deprecated models, relations, fields or enums, and relations into deprecated entities
+func (e Entity) GetDeprecationWarnings(allEntities map[string]Entity, allModels map[string]Model, allEnums map[string]Enum) []DeprecationWarning {
+	warnings := []DeprecationWarning{}
+	for _, fieldName := range core.MapKeysSorted(e.Fields) {
+		resolved, resolveErr := e.resolveFieldExpression(fieldName, e.Fields[fieldName], allModels, allEnums, nil)
+		if resolveErr != nil {
+			// Invalid field paths are reported by validation
+			continue
+		}
+
+		fieldWarnings := newDeprecationWarnings(fmt.Sprintf("entity '%s' field '%s'", e.Name, fieldName))
+		for _, resolvedPath := range resolved.Paths {
+			addResolvedPathDeprecations(fieldWarnings, resolvedPath)
+		}
+		warnings = append(warnings, fieldWarnings.warnings...)
+	}
+
+	for _, relationName := range core.MapKeysSorted(e.Related) {
+		relation := e.Related[relationName]
+		relationWarnings := newDeprecationWarnings(fmt.Sprintf("entity '%s' relation '%s'", e.Name, relationName))
+		for _, targetName := range getDeprecationRelationTargets(relationName, relation.Aliased, relation.For) {
+			if targetEntity, exists := allEntities[targetName]; exists {
+				relationWarnings.add(fmt.Sprintf("entity '%s'", targetName), targetEntity.Deprecated)
+			}
+		}
+		warnings = append(warnings, relationWarnings.warnings...)
+	}
+	return warnings
+}
+
+func addResolvedPathDeprecations(warnings *deprecationWarnings, resolvedPath ResolvedModelFieldPath) {
+	for _, model := range resolvedPath.Models {
+		warnings.add(fmt.Sprintf("model '%s'", model.Name), model.Deprecated)
+	}
+	for _, hop := range resolvedPath.Hops {
+		warnings.add(fmt.Sprintf("model '%s' relation '%s'", hop.FromModel, hop.RelationName), hop.Relation.Deprecated)
+	}
+	if resolvedPath.TerminalFieldName != "" {
+		warnings.add(fmt.Sprintf("model '%s' field '%s'", resolvedPath.TerminalModel().Name, resolvedPath.TerminalFieldName), resolvedPath.TerminalField.Deprecated)
+	}
+	if resolvedPath.Enum != nil {
+		warnings.add(fmt.Sprintf("enum '%s'", resolvedPath.Enum.Name), resolvedPath.Enum.Deprecated)
+	}
+}
+
+// getDeprecationRelationTargets returns the definitions a relation points to, by alias or relation name and polymorphic targets
+func getDeprecationRelationTargets(relationName string, aliased string, forNames []string) []string {
+	targetName := relationName
+	if strings.TrimSpace(aliased) != "" {
+		targetName = GetAliasedTargetName(aliased)
+	}
+	return append([]string{targetName}, forNames...)
+}
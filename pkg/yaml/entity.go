@@ -2,24 +2,34 @@ package yaml
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/kalo-build/clone"
+	"github.com/kalo-build/go-util/core"
 )
 
 type Entity struct {
 	Name        string                      `yaml:"name"`
+	Namespace   string                      `yaml:"namespace,omitempty"`
+	Includes    EntityIncludes              `yaml:"include,omitempty"`
 	Fields      map[string]EntityField      `yaml:"fields"`
 	Identifiers map[string]EntityIdentifier `yaml:"identifiers"`
 	Related     map[string]EntityRelation   `yaml:"related"`
+	Deprecated  *Deprecation                `yaml:"deprecated,omitempty"`
 }
 
 func (e Entity) DeepClone() Entity {
 	entityCopy := Entity{
 		Name:        e.Name,
+		Namespace:   e.Namespace,
 		Fields:      clone.DeepCloneMap(e.Fields),
 		Identifiers: clone.DeepCloneMap(e.Identifiers),
 		Related:     clone.DeepCloneMap(e.Related),
+		Deprecated:  e.Deprecated.DeepClone(),
+	}
+	if e.Includes != nil {
+		entityCopy.Includes = clone.DeepCloneSlice(e.Includes)
 	}
 
 	return entityCopy
@@ -29,6 +39,9 @@ func (e Entity) Validate(allEntities map[string]Entity, allModels map[string]Mod
 	if e.Name == "" {
 		return ErrNoMorpheEntityName
 	}
+	if !IsValidDefinitionName(e.Name) {
+		return ErrMorpheDefinitionNameNotCapitalized("entity", e.Name)
+	}
 
 	if len(e.Fields) == 0 {
 		return ErrNoMorpheEntityFields(e.Name)
@@ -42,7 +55,7 @@ func (e Entity) Validate(allEntities map[string]Entity, allModels map[string]Mod
 		return err
 	}
 
-	if err := e.validateAllIdentifiers(); err != nil {
+	if err := e.validateAllIdentifiers(allModels, allEnums); err != nil {
 		return err
 	}
 
@@ -53,20 +66,91 @@ func (e Entity) Validate(allEntities map[string]Entity, allModels map[string]Mod
 	return nil
 }
 
-func (e Entity) validateAllIdentifiers() error {
-	for identifierName, identifier := range e.Identifiers {
+func (e Entity) validateAllIdentifiers(allModels map[string]Model, allEnums map[string]Enum) error {
+	for _, identifierName := range core.MapKeysSorted(e.Identifiers) {
+		identifier := e.Identifiers[identifierName]
 		if len(identifier.Fields) == 0 {
 			return ErrNoMorpheEntityIdentifierFields(e.Name, identifierName)
 		}
 		for _, fieldName := range identifier.Fields {
-			if _, exists := e.Fields[fieldName]; !exists {
+			field, exists := e.Fields[fieldName]
+			if !exists {
 				return ErrUnknownMorpheEntityIdentifierField(e.Name, identifierName, fieldName)
 			}
+			if expression, parseErr := ParseEntityFieldExpression(field.Type); parseErr == nil && expression.IsComputed() {
+				return ErrMorpheEntityIdentifierComputedField(e.Name, identifierName, fieldName)
+			}
+		}
+		if err := e.validateIdentifierUniqueness(identifierName, identifier, allModels, allEnums); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// validateIdentifierUniqueness ensures that the identifier fields resolve through HasOne relations to fields of a single model that form one of its identifiers
+func (e Entity) validateIdentifierUniqueness(identifierName string, identifier EntityIdentifier, allModels map[string]Model, allEnums map[string]Enum) error {
+	var identifierModelPath string
+	var identifierModel Model
+	terminalFieldNames := make([]string, 0, len(identifier.Fields))
+	for _, fieldName := range identifier.Fields {
+		resolved, resolveErr := e.resolveFieldPath(fieldName, e.Fields[fieldName], allModels, allEnums, nil)
+		if resolveErr != nil {
+			return resolveErr
+		}
+
+		for _, hop := range resolved.Hops {
+			if hop.Relation.Type != "HasOne" {
+				return ErrMorpheEntityIdentifierNonUniqueRelation(e.Name, identifierName, fieldName, hop.RelationName, hop.Relation.Type)
+			}
+		}
+
+		modelPath := e.joinResolvedPathHops(resolved, len(resolved.Hops)-1)
+		if identifierModelPath == "" {
+			identifierModelPath = modelPath
+			identifierModel = resolved.TerminalModel()
+		}
+		if modelPath != identifierModelPath {
+			return ErrMorpheEntityIdentifierMixedModelPaths(e.Name, identifierName, identifierModelPath, modelPath)
+		}
+		terminalFieldNames = append(terminalFieldNames, resolved.TerminalFieldName)
+	}
+
+	if !e.isModelIdentifierCovered(identifierModel, terminalFieldNames) {
+		return ErrMorpheEntityIdentifierNotModelIdentifier(e.Name, identifierName, identifierModel.Name, terminalFieldNames)
+	}
+	return nil
+}
+
+// isModelIdentifierCovered checks whether the model field names include all fields of at least one model identifier
+func (e Entity) isModelIdentifierCovered(model Model, fieldNames []string) bool {
+	for _, modelIdentifier := range model.Identifiers {
+		if len(modelIdentifier.Fields) == 0 {
+			continue
+		}
+		covered := true
+		for _, modelIdentifierField := range modelIdentifier.Fields {
+			if !slices.Contains(fieldNames, modelIdentifierField) {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+// joinResolvedPathHops joins the root model name with the relation names up to and including the hop index
+func (e Entity) joinResolvedPathHops(resolved ResolvedModelFieldPath, lastHopIdx int) string {
+	segments := []string{resolved.RootModel().Name}
+	for i := 0; i <= lastHopIdx && i < len(resolved.Hops); i++ {
+		segments = append(segments, resolved.Hops[i].RelationName)
+	}
+	return strings.Join(segments, ".")
+}
+
 func (e Entity) validateAllFieldTypes(allModels map[string]Model, allEnums map[string]Enum) error {
 	for fieldName, field := range e.Fields {
 		if err := e.validateFieldType(fieldName, field, allModels, allEnums); err != nil {
@@ -85,35 +169,234 @@ func (e Entity) validateAllRelations(allEntities map[string]Entity) error {
 	return nil
 }
 
+// ResolveFieldPath resolves the model field path of an entity field into its full traversal
+func (e Entity) ResolveFieldPath(fieldName string, allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (ResolvedModelFieldPath, error) {
+	field, exists := e.Fields[fieldName]
+	if !exists {
+		return ResolvedModelFieldPath{}, ErrUnknownMorpheEntityField(e.Name, fieldName)
+	}
+	return e.resolveFieldPath(fieldName, field, allModels, allEnums, allStructures)
+}
+
+// ResolveAllFieldPaths resolves the model field paths of all non-computed entity fields, keyed by entity field name
+func (e Entity) ResolveAllFieldPaths(allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (map[string]ResolvedModelFieldPath, error) {
+	allResolved := make(map[string]ResolvedModelFieldPath, len(e.Fields))
+	for _, fieldName := range core.MapKeysSorted(e.Fields) {
+		field := e.Fields[fieldName]
+		if expression, parseErr := ParseEntityFieldExpression(field.Type); parseErr == nil && expression.IsComputed() {
+			continue
+		}
+		resolved, resolveErr := e.resolveFieldPath(fieldName, field, allModels, allEnums, allStructures)
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		allResolved[fieldName] = resolved
+	}
+	return allResolved, nil
+}
+
+// ResolveFieldExpression resolves an entity field type, plain path or computed, into its model traversals and result type
+func (e Entity) ResolveFieldExpression(fieldName string, allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (ResolvedEntityFieldExpression, error) {
+	field, exists := e.Fields[fieldName]
+	if !exists {
+		return ResolvedEntityFieldExpression{}, ErrUnknownMorpheEntityField(e.Name, fieldName)
+	}
+	return e.resolveFieldExpression(fieldName, field, allModels, allEnums, allStructures)
+}
+
+// ResolveRootModelName returns the model an entity reads from, the root model of its primary identifier field or otherwise of its first field by name
+func (e Entity) ResolveRootModelName(allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (string, error) {
+	if len(e.Fields) == 0 {
+		return "", ErrNoMorpheEntityFields(e.Name)
+	}
+	rootFieldName := core.MapKeysSorted(e.Fields)[0]
+	if primary, hasPrimary := e.Identifiers["primary"]; hasPrimary && len(primary.Fields) > 0 {
+		rootFieldName = primary.Fields[0]
+	}
+
+	resolved, resolveErr := e.ResolveFieldExpression(rootFieldName, allModels, allEnums, allStructures)
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	if len(resolved.Paths) == 0 {
+		return "", ErrNoMorpheEntityFieldRootModel(e.Name, rootFieldName)
+	}
+	return resolved.Paths[0].RootModel().Name, nil
+}
+
 func (e Entity) validateFieldType(fieldName string, field EntityField, allModels map[string]Model, allEnums map[string]Enum) error {
+	_, resolveErr := e.resolveFieldExpression(fieldName, field, allModels, allEnums, nil)
+	return resolveErr
+}
+
+func (e Entity) resolveFieldPath(fieldName string, field EntityField, allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (ResolvedModelFieldPath, error) {
+	if field.Type == "" {
+		return ResolvedModelFieldPath{}, ErrNoMorpheEntityFieldType(e.Name, fieldName)
+	}
+
+	expression, parseErr := ParseEntityFieldExpression(field.Type)
+	if parseErr != nil {
+		return ResolvedModelFieldPath{}, ErrInvalidMorpheEntityFieldExpression(e.Name, fieldName, parseErr)
+	}
+	if expression.IsComputed() {
+		return ResolvedModelFieldPath{}, ErrMorpheEntityFieldComputed(e.Name, fieldName)
+	}
+
+	return e.resolvePath(fieldName, field.Type, false, allModels, allEnums, allStructures)
+}
+
+func (e Entity) resolveFieldExpression(fieldName string, field EntityField, allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (ResolvedEntityFieldExpression, error) {
 	if field.Type == "" {
-		return ErrNoMorpheEntityFieldType(e.Name, fieldName)
+		return ResolvedEntityFieldExpression{}, ErrNoMorpheEntityFieldType(e.Name, fieldName)
+	}
+
+	expression, parseErr := ParseEntityFieldExpression(field.Type)
+	if parseErr != nil {
+		return ResolvedEntityFieldExpression{}, ErrInvalidMorpheEntityFieldExpression(e.Name, fieldName, parseErr)
 	}
 
-	fieldPath := e.parseFieldTypePath(field.Type)
+	resolved := ResolvedEntityFieldExpression{
+		Expression: expression,
+	}
+	switch {
+	case !expression.IsComputed():
+		resolvedPath, pathErr := e.resolvePath(fieldName, expression.Arguments[0].Path, false, allModels, allEnums, allStructures)
+		if pathErr != nil {
+			return ResolvedEntityFieldExpression{}, pathErr
+		}
+		resolved.Paths = []ResolvedModelFieldPath{resolvedPath}
+		resolved.Type = resolvedPath.TerminalField.Type
+
+	case expression.Function == EntityFieldFunctionCount:
+		resolvedPath, pathErr := e.resolveRelationPath(fieldName, expression.Arguments[0].Path, allModels)
+		if pathErr != nil {
+			return ResolvedEntityFieldExpression{}, pathErr
+		}
+		if !resolvedPath.IsToMany() {
+			return ResolvedEntityFieldExpression{}, ErrInvalidMorpheEntityFieldFunction(e.Name, fieldName, expression.Function,
+				fmt.Sprintf("path %s does not traverse a to-many relationship", resolvedPath.Path))
+		}
+		resolved.Paths = []ResolvedModelFieldPath{resolvedPath}
+		resolved.Type = ModelFieldTypeInteger
+
+	case IsEntityFieldFunctionAggregate(expression.Function):
+		resolvedPath, pathErr := e.resolvePath(fieldName, expression.Arguments[0].Path, true, allModels, allEnums, allStructures)
+		if pathErr != nil {
+			return ResolvedEntityFieldExpression{}, pathErr
+		}
+		if !resolvedPath.IsToMany() {
+			return ResolvedEntityFieldExpression{}, ErrInvalidMorpheEntityFieldFunction(e.Name, fieldName, expression.Function,
+				fmt.Sprintf("path %s does not traverse a to-many relationship", resolvedPath.Path))
+		}
+		resultType, resultTypeErr := e.getAggregateResultType(fieldName, expression.Function, resolvedPath)
+		if resultTypeErr != nil {
+			return ResolvedEntityFieldExpression{}, resultTypeErr
+		}
+		resolved.Paths = []ResolvedModelFieldPath{resolvedPath}
+		resolved.Type = resultType
+
+	case expression.Function == EntityFieldFunctionConcat:
+		resolved.Paths = []ResolvedModelFieldPath{}
+		for _, argumentPath := range expression.GetPaths() {
+			resolvedPath, pathErr := e.resolvePath(fieldName, argumentPath, false, allModels, allEnums, allStructures)
+			if pathErr != nil {
+				return ResolvedEntityFieldExpression{}, pathErr
+			}
+			if !e.isConcatenable(resolvedPath) {
+				return ResolvedEntityFieldExpression{}, ErrInvalidMorpheEntityFieldFunction(e.Name, fieldName, expression.Function,
+					fmt.Sprintf("path %s has unsupported type '%s'", argumentPath, resolvedPath.TerminalField.Type))
+			}
+			resolved.Paths = append(resolved.Paths, resolvedPath)
+		}
+		resolved.Type = ModelFieldTypeString
+	}
+
+	return resolved, nil
+}
+
+// getAggregateResultType returns the primitive type produced by aggregating the terminal field of a to-many path
+func (e Entity) getAggregateResultType(fieldName string, function EntityFieldFunction, resolvedPath ResolvedModelFieldPath) (ModelFieldType, error) {
+	sourceType := resolvedPath.Primitive
+	isNumeric := sourceType == ModelFieldTypeInteger || sourceType == ModelFieldTypeFloat || sourceType == ModelFieldTypeAutoIncrement
+	isOrdered := isNumeric || sourceType == ModelFieldTypeString || sourceType == ModelFieldTypeTime || sourceType == ModelFieldTypeDate
+
+	switch {
+	case function == EntityFieldFunctionSum && isNumeric:
+		if sourceType == ModelFieldTypeFloat {
+			return ModelFieldTypeFloat, nil
+		}
+		return ModelFieldTypeInteger, nil
+	case function == EntityFieldFunctionAvg && isNumeric:
+		return ModelFieldTypeFloat, nil
+	case (function == EntityFieldFunctionMin || function == EntityFieldFunctionMax) && isOrdered:
+		return sourceType, nil
+	}
+
+	return "", ErrInvalidMorpheEntityFieldFunction(e.Name, fieldName, function,
+		fmt.Sprintf("path %s has unsupported type '%s'", resolvedPath.Path, resolvedPath.TerminalField.Type))
+}
+
+func (e Entity) isConcatenable(resolvedPath ResolvedModelFieldPath) bool {
+	switch resolvedPath.TypeKind {
+	case ModelFieldPathTypeKindEnum:
+		return true
+	case ModelFieldPathTypeKindPrimitive:
+		return resolvedPath.Primitive != ModelFieldTypeProtected && resolvedPath.Primitive != ModelFieldTypeSealed
+	}
+	return false
+}
+
+func (e Entity) resolvePath(fieldName string, fieldType ModelFieldPath, allowToMany bool, allModels map[string]Model, allEnums map[string]Enum, allStructures map[string]Structure) (ResolvedModelFieldPath, error) {
+	fieldPath := e.parseFieldTypePath(fieldType)
 	if pathValidationErr := e.validateFieldTypePath(fieldPath, fieldName); pathValidationErr != nil {
-		return pathValidationErr
+		return ResolvedModelFieldPath{}, pathValidationErr
 	}
 
 	rootModel, rootModelErr := e.resolveRootModel(fieldPath[0], fieldName, allModels)
 	if rootModelErr != nil {
-		return rootModelErr
+		return ResolvedModelFieldPath{}, rootModelErr
 	}
 
-	currentModel, modelPathErr := e.resolveModelFieldPath(rootModel, fieldPath[1:len(fieldPath)-1], fieldName, field.Type, allModels)
+	resolved := ResolvedModelFieldPath{
+		Path: fieldType,
+	}
+	modelPathErr := e.resolveModelFieldPath(&resolved, rootModel, fieldPath[1:len(fieldPath)-1], fieldName, fieldType, allowToMany, allModels)
 	if modelPathErr != nil {
-		return modelPathErr
+		return ResolvedModelFieldPath{}, modelPathErr
 	}
 
-	if terminalFieldErr := e.validateTerminalField(currentModel, fieldPath[len(fieldPath)-1], fieldName, field.Type, allEnums); terminalFieldErr != nil {
-		return terminalFieldErr
+	terminalFieldErr := e.resolveTerminalField(&resolved, fieldPath[len(fieldPath)-1], fieldName, fieldType, allEnums, allStructures)
+	if terminalFieldErr != nil {
+		return ResolvedModelFieldPath{}, terminalFieldErr
 	}
 
-	return nil
+	return resolved, nil
+}
+
+// resolveRelationPath resolves a path made up of relations only, such as the argument of 'count'
+func (e Entity) resolveRelationPath(fieldName string, relationPath ModelFieldPath, allModels map[string]Model) (ResolvedModelFieldPath, error) {
+	pathSegments := e.parseFieldTypePath(relationPath)
+	if pathValidationErr := e.validateFieldTypePath(pathSegments, fieldName); pathValidationErr != nil {
+		return ResolvedModelFieldPath{}, pathValidationErr
+	}
+
+	rootModel, rootModelErr := e.resolveRootModel(pathSegments[0], fieldName, allModels)
+	if rootModelErr != nil {
+		return ResolvedModelFieldPath{}, rootModelErr
+	}
+
+	resolved := ResolvedModelFieldPath{
+		Path: relationPath,
+	}
+	modelPathErr := e.resolveModelFieldPath(&resolved, rootModel, pathSegments[1:], fieldName, relationPath, true, allModels)
+	if modelPathErr != nil {
+		return ResolvedModelFieldPath{}, modelPathErr
+	}
+	return resolved, nil
 }
 
 func (e Entity) parseFieldTypePath(fieldType ModelFieldPath) []string {
-	return strings.Split(string(fieldType), ".")
+	return SplitModelFieldPath(fieldType)
 }
 
 func (e Entity) validateFieldTypePath(fieldPath []string, fieldName string) error {
@@ -131,11 +414,13 @@ func (e Entity) resolveRootModel(rootModelName string, fieldName string, allMode
 	return rootModel, nil
 }
 
-func (e Entity) resolveModelFieldPath(startModel Model, pathSegments []string, fieldName string, fieldType ModelFieldPath, allModels map[string]Model) (Model, error) {
+func (e Entity) resolveModelFieldPath(resolved *ResolvedModelFieldPath, startModel Model, pathSegments []string, fieldName string, fieldType ModelFieldPath, allowToMany bool, allModels map[string]Model) error {
 	currentModel := startModel
+	resolved.Models = []Model{startModel.DeepClone()}
+	resolved.Hops = []ModelFieldPathHop{}
 	for i, relatedName := range pathSegments {
 		if relationValidationErr := e.validateModelRelation(currentModel, relatedName, fieldName, fieldType); relationValidationErr != nil {
-			return Model{}, relationValidationErr
+			return relationValidationErr
 		}
 
 		// Get the relation to check for aliasing and polymorphism
@@ -145,17 +430,32 @@ func (e Entity) resolveModelFieldPath(startModel Model, pathSegments []string, f
 		if e.isRelationPoly(relation.Type) {
 			// Build the path up to this point for a better error message
 			partialPath := strings.Join(append([]string{startModel.Name}, pathSegments[:i+1]...), ".")
-			return Model{}, fmt.Errorf("morphe entity %s field %s cannot traverse through polymorphic relationship %s in path %s",
+			return fmt.Errorf("morphe entity %s field %s cannot traverse through polymorphic relationship %s in path %s",
 				e.Name, fieldName, relatedName, partialPath)
 		}
 
+		// A to-many relationship has no single value to project onto the entity field unless it is aggregated
+		if e.isRelationMany(relation.Type) && !allowToMany {
+			partialPath := strings.Join(append([]string{startModel.Name}, pathSegments[:i+1]...), ".")
+			return ErrMorpheEntityFieldToManyTraversal(e.Name, fieldName, relatedName, partialPath)
+		}
+
 		nextModel, relatedModelErr := e.resolveRelatedModel(relatedName, relation, fieldName, fieldType, allModels)
 		if relatedModelErr != nil {
-			return Model{}, relatedModelErr
+			return relatedModelErr
 		}
+
+		resolved.Hops = append(resolved.Hops, ModelFieldPathHop{
+			RelationName: relatedName,
+			Relation:     relation.DeepClone(),
+			FromModel:    currentModel.Name,
+			ToModel:      nextModel.Name,
+			Cardinality:  e.getRelationCardinality(relation.Type),
+		})
+		resolved.Models = append(resolved.Models, nextModel.DeepClone())
 		currentModel = nextModel
 	}
-	return currentModel, nil
+	return nil
 }
 
 func (e Entity) validateModelRelation(model Model, relatedName string, fieldName string, fieldType ModelFieldPath) error {
@@ -176,9 +476,8 @@ func (e Entity) resolveRelatedModel(relatedName string, relation ModelRelation,
 		// For polymorphic relationships, check if the alias contains a dot
 		// Example: "Comment.Commentable" where Commentable is the inverse
 		if e.isRelationPoly(relation.Type) && strings.Contains(targetModelName, ".") {
-			// Extract just the model name part
-			parts := strings.Split(targetModelName, ".")
-			targetModelName = parts[0]
+			// Extract just the model name part, including its namespace
+			targetModelName = GetAliasedTargetName(targetModelName)
 			// Note: The inverse relationship validation happens separately in validateRelation
 
 			// Validate that we have a non-empty model name after splitting
@@ -201,22 +500,37 @@ func (e Entity) resolveRelatedModel(relatedName string, relation ModelRelation,
 	return relatedModel, nil
 }
 
-func (e Entity) validateTerminalField(model Model, fieldName string, originalFieldName string, fieldType ModelFieldPath, allEnums map[string]Enum) error {
+func (e Entity) resolveTerminalField(resolved *ResolvedModelFieldPath, fieldName string, originalFieldName string, fieldType ModelFieldPath, allEnums map[string]Enum, allStructures map[string]Structure) error {
+	model := resolved.TerminalModel()
 	terminalField, exists := model.Fields[fieldName]
 	if !exists {
 		return ErrUnknownMorpheEntityFieldTerminalField(e.Name, originalFieldName, fieldName, fieldType)
 	}
+	resolved.TerminalFieldName = fieldName
+	resolved.TerminalField = terminalField.DeepClone()
+
 	if IsModelFieldTypePrimitive(terminalField.Type) {
+		resolved.TypeKind = ModelFieldPathTypeKindPrimitive
+		resolved.Primitive = terminalField.Type
 		return nil
 	}
 
 	terminalFieldTypeString := string(terminalField.Type)
-	_, enumExists := allEnums[terminalFieldTypeString]
-	if !enumExists {
-		return ErrUnknownMorpheEntityFieldType(e.Name, fieldName, terminalFieldTypeString)
+	if enum, enumExists := allEnums[terminalFieldTypeString]; enumExists {
+		enumClone := enum.DeepClone()
+		resolved.TypeKind = ModelFieldPathTypeKindEnum
+		resolved.Enum = &enumClone
+		return nil
 	}
 
-	return nil
+	if structure, structureExists := allStructures[terminalFieldTypeString]; structureExists {
+		structureClone := structure.DeepClone()
+		resolved.TypeKind = ModelFieldPathTypeKindStructure
+		resolved.Structure = &structureClone
+		return nil
+	}
+
+	return ErrUnknownMorpheEntityFieldType(e.Name, fieldName, terminalFieldTypeString)
 }
 
 func (e Entity) validateRelation(relatedName string, relation EntityRelation, allEntities map[string]Entity) error {
@@ -327,6 +641,17 @@ func (e Entity) isRelationHas(relationType string) bool {
 	return strings.HasPrefix(strings.ToLower(relationType), "has")
 }
 
+func (e Entity) isRelationMany(relationType string) bool {
+	return strings.Contains(strings.ToLower(relationType), "many")
+}
+
+func (e Entity) getRelationCardinality(relationType string) RelationCardinality {
+	if e.isRelationMany(relationType) {
+		return RelationCardinalityMany
+	}
+	return RelationCardinalityOne
+}
+
 func (e Entity) isRelationPoly(relationType string) bool {
 	lowerType := strings.ToLower(relationType)
 	return (e.isRelationFor(relationType) || e.isRelationHas(relationType)) &&
@@ -8,6 +8,10 @@ func ErrNoMorpheEntityFields(entityName string) error {
 	return fmt.Errorf("morphe entity %s has no fields", entityName)
 }
 
+func ErrUnknownMorpheEntityField(entityName string, fieldName string) error {
+	return fmt.Errorf("morphe entity %s has no field %s", entityName, fieldName)
+}
+
 func ErrNoMorpheEntityFieldType(entityName string, fieldName string) error {
 	return fmt.Errorf("morphe entity %s field %s has no type", entityName, fieldName)
 }
@@ -20,6 +24,10 @@ func ErrUnknownMorpheEntityFieldRootModel(entityName string, fieldName string, r
 	return fmt.Errorf("morphe entity %s field %s references unknown root model: %s", entityName, fieldName, rootModelName)
 }
 
+func ErrNoMorpheEntityFieldRootModel(entityName string, fieldName string) error {
+	return fmt.Errorf("morphe entity %s field %s does not reference a root model", entityName, fieldName)
+}
+
 func ErrUnknownMorpheEntityFieldRelatedModel(entityName string, fieldName string, relatedName string, fieldType ModelFieldPath) error {
 	return fmt.Errorf("morphe entity %s field %s references unknown related model: %s in path %s", entityName, fieldName, relatedName, fieldType)
 }
@@ -32,6 +40,22 @@ func ErrUnknownMorpheEntityFieldTerminalField(entityName string, fieldName strin
 	return fmt.Errorf("morphe entity %s field %s references unknown terminal field: %s in path %s", entityName, fieldName, terminalFieldName, fieldType)
 }
 
+func ErrMorpheEntityFieldToManyTraversal(entityName string, fieldName string, relatedName string, partialPath string) error {
+	return fmt.Errorf("morphe entity %s field %s cannot traverse through to-many relationship %s in path %s", entityName, fieldName, relatedName, partialPath)
+}
+
+func ErrInvalidMorpheEntityFieldExpression(entityName string, fieldName string, expressionErr error) error {
+	return fmt.Errorf("morphe entity %s field %s has an %w", entityName, fieldName, expressionErr)
+}
+
+func ErrInvalidMorpheEntityFieldFunction(entityName string, fieldName string, function EntityFieldFunction, reason string) error {
+	return fmt.Errorf("morphe entity %s field %s has invalid use of function '%s': %s", entityName, fieldName, function, reason)
+}
+
+func ErrMorpheEntityFieldComputed(entityName string, fieldName string) error {
+	return fmt.Errorf("morphe entity %s field %s is computed and has no single model field path", entityName, fieldName)
+}
+
 func ErrNoMorpheEntityRelationType(entityName string, relatedName string) error {
 	return fmt.Errorf("morphe entity %s relation %s has no type", entityName, relatedName)
 }
@@ -56,6 +80,22 @@ func ErrUnknownMorpheEntityIdentifierField(entityName string, identifierName str
 	return fmt.Errorf("entity '%s' identifier '%s' references unknown field '%s'", entityName, identifierName, fieldName)
 }
 
+func ErrMorpheEntityIdentifierComputedField(entityName string, identifierName string, fieldName string) error {
+	return fmt.Errorf("entity '%s' identifier '%s' references computed field '%s'", entityName, identifierName, fieldName)
+}
+
+func ErrMorpheEntityIdentifierMixedModelPaths(entityName string, identifierName string, modelPath string, otherModelPath string) error {
+	return fmt.Errorf("entity '%s' identifier '%s' spans fields of different model paths: %s and %s", entityName, identifierName, modelPath, otherModelPath)
+}
+
+func ErrMorpheEntityIdentifierNotModelIdentifier(entityName string, identifierName string, modelName string, modelFieldNames []string) error {
+	return fmt.Errorf("entity '%s' identifier '%s' fields %v do not form an identifier of model '%s'", entityName, identifierName, modelFieldNames, modelName)
+}
+
+func ErrMorpheEntityIdentifierNonUniqueRelation(entityName string, identifierName string, fieldName string, relationName string, relationType string) error {
+	return fmt.Errorf("entity '%s' identifier '%s' field '%s' traverses %s relation '%s', only HasOne relations keep identifier values unique", entityName, identifierName, fieldName, relationType, relationName)
+}
+
 func ErrMorpheEntityPolyRelationMissingFor(entityName string, relatedName string, relationType string) error {
 	return fmt.Errorf("morphe entity %s polymorphic relation %s of type %s is missing required 'for' property", entityName, relatedName, relationType)
 }
@@ -71,3 +111,19 @@ func ErrMorpheEntityUnknownAliasedTarget(entityName string, relationName string,
 func ErrMorpheEntityPolymorphicInverseValidation(entityName string, relationName string, aliasedTarget string, through string, reason string) error {
 	return fmt.Errorf("morphe entity '%s' polymorphic inverse relation '%s' (aliased: %s, through: %s): %s", entityName, relationName, aliasedTarget, through, reason)
 }
+
+func ErrInvalidMorpheFieldExpression(expression string, reason string) error {
+	return fmt.Errorf("invalid expression '%s': %s", expression, reason)
+}
+
+func ErrInvalidMorpheEntityInclude(entityName string, from ModelFieldPath) error {
+	return fmt.Errorf("morphe entity %s include %s must be a model path ending in '%s'", entityName, from, EntityIncludeWildcard)
+}
+
+func ErrUnknownMorpheEntityIncludeField(entityName string, from ModelFieldPath, fieldName string) error {
+	return fmt.Errorf("morphe entity %s include %s references unknown field: %s", entityName, from, fieldName)
+}
+
+func ErrMorpheEntityIncludeFieldConflict(entityName string, fieldName string, from ModelFieldPath, otherFrom ModelFieldPath) error {
+	return fmt.Errorf("morphe entity %s field %s is included by both %s and %s", entityName, fieldName, otherFrom, from)
+}
@@ -7,11 +7,13 @@ import (
 type EntityField struct {
 	Type       ModelFieldPath `yaml:"type"`
 	Attributes []string       `yaml:"attributes"`
+	Deprecated *Deprecation   `yaml:"deprecated,omitempty"`
 }
 
 func (f EntityField) DeepClone() EntityField {
 	return EntityField{
 		Type:       f.Type,
 		Attributes: clone.Slice(f.Attributes),
+		Deprecated: f.Deprecated.DeepClone(),
 	}
 }
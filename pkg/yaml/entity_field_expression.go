@@ -0,0 +1,243 @@
+package yaml
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// EntityFieldFunction is a function that computes an entity field value from model field paths
+type EntityFieldFunction string
+
+const (
+	EntityFieldFunctionCount  EntityFieldFunction = "count"
+	EntityFieldFunctionSum    EntityFieldFunction = "sum"
+	EntityFieldFunctionAvg    EntityFieldFunction = "avg"
+	EntityFieldFunctionMin    EntityFieldFunction = "min"
+	EntityFieldFunctionMax    EntityFieldFunction = "max"
+	EntityFieldFunctionConcat EntityFieldFunction = "concat"
+)
+
+var EntityFieldFunctions = []EntityFieldFunction{
+	EntityFieldFunctionCount,
+	EntityFieldFunctionSum,
+	EntityFieldFunctionAvg,
+	EntityFieldFunctionMin,
+	EntityFieldFunctionMax,
+	EntityFieldFunctionConcat,
+}
+
+var EntityFieldAggregateFunctions = []EntityFieldFunction{
+	EntityFieldFunctionCount,
+	EntityFieldFunctionSum,
+	EntityFieldFunctionAvg,
+	EntityFieldFunctionMin,
+	EntityFieldFunctionMax,
+}
+
+func IsEntityFieldFunctionAggregate(function EntityFieldFunction) bool {
+	return slices.Contains(EntityFieldAggregateFunctions, function)
+}
+
+// EntityFieldExpression is the parsed form of an entity field type, either a plain model field path or a function call
+type EntityFieldExpression struct {
+	// Function is empty for plain model field paths
+	Function  EntityFieldFunction
+	Arguments []EntityFieldExpressionArgument
+}
+
+// EntityFieldExpressionArgument is either a model field path or a string literal
+type EntityFieldExpressionArgument struct {
+	Path      ModelFieldPath
+	Literal   string
+	IsLiteral bool
+}
+
+// ResolvedEntityFieldExpression is an entity field expression resolved against the model graph
+type ResolvedEntityFieldExpression struct {
+	Expression EntityFieldExpression
+
+	// Paths holds the resolved traversal of each path argument, in argument order
+	Paths []ResolvedModelFieldPath
+
+	// Type is the result type of the expression, an enum name for plain paths to enum fields
+	Type ModelFieldType
+}
+
+// IsComputed returns true if the expression is a function call rather than a plain model field path
+func (x EntityFieldExpression) IsComputed() bool {
+	return x.Function != ""
+}
+
+// GetPaths returns the model field paths of all non-literal arguments
+func (x EntityFieldExpression) GetPaths() []ModelFieldPath {
+	paths := []ModelFieldPath{}
+	for _, argument := range x.Arguments {
+		if argument.IsLiteral {
+			continue
+		}
+		paths = append(paths, argument.Path)
+	}
+	return paths
+}
+
+// String formats the expression in entity field type syntax, such as 'concat(Person.FirstName, " ", Person.LastName)'
+func (x EntityFieldExpression) String() string {
+	if !x.IsComputed() {
+		if len(x.Arguments) == 0 {
+			return ""
+		}
+		return string(x.Arguments[0].Path)
+	}
+
+	arguments := make([]string, len(x.Arguments))
+	for i, argument := range x.Arguments {
+		if !argument.IsLiteral {
+			arguments[i] = string(argument.Path)
+			continue
+		}
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(argument.Literal)
+		arguments[i] = `"` + escaped + `"`
+	}
+	return fmt.Sprintf("%s(%s)", x.Function, strings.Join(arguments, ", "))
+}
+
+var entityFieldFunctionCallRegexp = regexp.MustCompile(`^([A-Za-z]+)\s*\((.*)\)$`)
+
+// ParseEntityFieldExpression parses an entity field type such as 'Person.FirstName' or 'count(Person.Orders)'
+func ParseEntityFieldExpression(fieldType ModelFieldPath) (EntityFieldExpression, error) {
+	expression := strings.TrimSpace(string(fieldType))
+	if expression == "" {
+		return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, "expression is empty")
+	}
+
+	callMatch := entityFieldFunctionCallRegexp.FindStringSubmatch(expression)
+	if callMatch == nil {
+		if strings.ContainsAny(expression, "(),\"'") {
+			return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, "malformed function call")
+		}
+		pathArgument, pathErr := parseEntityFieldExpressionPath(expression)
+		if pathErr != nil {
+			return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, pathErr.Error())
+		}
+		return EntityFieldExpression{
+			Arguments: []EntityFieldExpressionArgument{pathArgument},
+		}, nil
+	}
+
+	function := EntityFieldFunction(callMatch[1])
+	if !slices.Contains(EntityFieldFunctions, function) {
+		return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, fmt.Sprintf("unknown function '%s'", function))
+	}
+
+	arguments, argumentsErr := parseEntityFieldExpressionArguments(callMatch[2])
+	if argumentsErr != nil {
+		return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, argumentsErr.Error())
+	}
+
+	parsed := EntityFieldExpression{
+		Function:  function,
+		Arguments: arguments,
+	}
+	if arityErr := validateEntityFieldExpressionArity(parsed); arityErr != nil {
+		return EntityFieldExpression{}, ErrInvalidMorpheFieldExpression(expression, arityErr.Error())
+	}
+	return parsed, nil
+}
+
+func parseEntityFieldExpressionArguments(argumentsString string) ([]EntityFieldExpressionArgument, error) {
+	arguments := []EntityFieldExpressionArgument{}
+	remaining := strings.TrimSpace(argumentsString)
+	if remaining == "" {
+		return arguments, nil
+	}
+
+	for {
+		var argument EntityFieldExpressionArgument
+		var rest string
+		if remaining == "" {
+			return nil, fmt.Errorf("missing argument")
+		}
+
+		if remaining[0] == '"' || remaining[0] == '\'' {
+			literal, literalRest, literalErr := parseEntityFieldExpressionLiteral(remaining)
+			if literalErr != nil {
+				return nil, literalErr
+			}
+			argument = EntityFieldExpressionArgument{Literal: literal, IsLiteral: true}
+			rest = literalRest
+		} else {
+			pathEnd := strings.IndexByte(remaining, ',')
+			if pathEnd == -1 {
+				pathEnd = len(remaining)
+			}
+			pathArgument, pathErr := parseEntityFieldExpressionPath(strings.TrimSpace(remaining[:pathEnd]))
+			if pathErr != nil {
+				return nil, pathErr
+			}
+			argument = pathArgument
+			rest = remaining[pathEnd:]
+		}
+		arguments = append(arguments, argument)
+
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			return arguments, nil
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("expected ',' before '%s'", rest)
+		}
+		remaining = strings.TrimSpace(rest[1:])
+	}
+}
+
+func parseEntityFieldExpressionLiteral(input string) (string, string, error) {
+	quote := input[0]
+	var builder strings.Builder
+	for i := 1; i < len(input); i++ {
+		char := input[i]
+		if char == '\\' && i+1 < len(input) {
+			builder.WriteByte(input[i+1])
+			i++
+			continue
+		}
+		if char == quote {
+			return builder.String(), input[i+1:], nil
+		}
+		builder.WriteByte(char)
+	}
+	return "", "", fmt.Errorf("unterminated string literal")
+}
+
+func parseEntityFieldExpressionPath(path string) (EntityFieldExpressionArgument, error) {
+	if path == "" {
+		return EntityFieldExpressionArgument{}, fmt.Errorf("missing argument")
+	}
+	if strings.ContainsAny(path, " \t()\"'") {
+		return EntityFieldExpressionArgument{}, fmt.Errorf("invalid path '%s'", path)
+	}
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return EntityFieldExpressionArgument{}, fmt.Errorf("invalid path '%s'", path)
+		}
+	}
+	return EntityFieldExpressionArgument{Path: ModelFieldPath(path)}, nil
+}
+
+func validateEntityFieldExpressionArity(expression EntityFieldExpression) error {
+	if expression.Function == EntityFieldFunctionConcat {
+		if len(expression.Arguments) < 2 {
+			return fmt.Errorf("function '%s' expects at least 2 arguments", expression.Function)
+		}
+		return nil
+	}
+
+	if len(expression.Arguments) != 1 {
+		return fmt.Errorf("function '%s' expects exactly 1 argument", expression.Function)
+	}
+	if expression.Arguments[0].IsLiteral {
+		return fmt.Errorf("function '%s' expects a path argument", expression.Function)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEntityFieldExpression_PlainPath(t *testing.T) {
+	expression, err := ParseEntityFieldExpression("Person.ContactInfo.Email")
+	require.NoError(t, err)
+
+	assert.False(t, expression.IsComputed())
+	assert.Empty(t, expression.Function)
+	require.Len(t, expression.Arguments, 1)
+	assert.Equal(t, ModelFieldPath("Person.ContactInfo.Email"), expression.Arguments[0].Path)
+	assert.False(t, expression.Arguments[0].IsLiteral)
+}
+
+func TestParseEntityFieldExpression_Count(t *testing.T) {
+	expression, err := ParseEntityFieldExpression("count(Person.Orders)")
+	require.NoError(t, err)
+
+	assert.True(t, expression.IsComputed())
+	assert.Equal(t, EntityFieldFunctionCount, expression.Function)
+	assert.Equal(t, []ModelFieldPath{"Person.Orders"}, expression.GetPaths())
+}
+
+func TestParseEntityFieldExpression_ConcatWithLiterals(t *testing.T) {
+	expression, err := ParseEntityFieldExpression(`concat(Person.FirstName, " ", Person.LastName, ', \'Jr\'')`)
+	require.NoError(t, err)
+
+	assert.Equal(t, EntityFieldFunctionConcat, expression.Function)
+	require.Len(t, expression.Arguments, 4)
+	assert.Equal(t, ModelFieldPath("Person.FirstName"), expression.Arguments[0].Path)
+	assert.True(t, expression.Arguments[1].IsLiteral)
+	assert.Equal(t, " ", expression.Arguments[1].Literal)
+	assert.Equal(t, ModelFieldPath("Person.LastName"), expression.Arguments[2].Path)
+	assert.True(t, expression.Arguments[3].IsLiteral)
+	assert.Equal(t, ", 'Jr'", expression.Arguments[3].Literal)
+	assert.Equal(t, []ModelFieldPath{"Person.FirstName", "Person.LastName"}, expression.GetPaths())
+}
+
+func TestParseEntityFieldExpression_Invalid(t *testing.T) {
+	testCases := []struct {
+		expression ModelFieldPath
+		reason     string
+	}{
+		{"", "expression is empty"},
+		{"median(Person.Orders.Total)", "unknown function 'median'"},
+		{"count(Person.Orders", "malformed function call"},
+		{"count()", "expects exactly 1 argument"},
+		{"count(Person.Orders, Person.Pets)", "expects exactly 1 argument"},
+		{"max('Total')", "expects a path argument"},
+		{"concat(Person.FirstName)", "expects at least 2 arguments"},
+		{`concat(Person.FirstName, "unterminated)`, "unterminated string literal"},
+		{"concat(Person.FirstName,, Person.LastName)", "missing argument"},
+		{"Person..Name", "invalid path 'Person..Name'"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := ParseEntityFieldExpression(testCase.expression)
+		require.Error(t, err, testCase.expression)
+		assert.Contains(t, err.Error(), testCase.reason, testCase.expression)
+	}
+}
@@ -0,0 +1,178 @@
+package yaml
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kalo-build/clone"
+	"github.com/kalo-build/go-util/core"
+	"gopkg.in/yaml.v3"
+)
+
+// EntityIncludeWildcard is the path suffix that selects all fields of a model
+const EntityIncludeWildcard = ".*"
+
+// EntityInclude pulls all fields of a model into an entity, such as 'Person.*' or 'Person.ContactInfo.*'
+type EntityInclude struct {
+	From    ModelFieldPath    `yaml:"from"`
+	Exclude []string          `yaml:"exclude,omitempty"`
+	Rename  map[string]string `yaml:"rename,omitempty"`
+}
+
+func (i EntityInclude) DeepClone() EntityInclude {
+	return EntityInclude{
+		From:    i.From,
+		Exclude: clone.Slice(i.Exclude),
+		Rename:  maps.Clone(i.Rename),
+	}
+}
+
+// GetModelPath returns the path of the included model without the wildcard, such as 'Person.ContactInfo'
+func (i EntityInclude) GetModelPath() (ModelFieldPath, bool) {
+	from := string(i.From)
+	if !strings.HasSuffix(from, EntityIncludeWildcard) {
+		return "", false
+	}
+	modelPath := strings.TrimSuffix(from, EntityIncludeWildcard)
+	if modelPath == "" || slices.Contains(strings.Split(modelPath, "."), "") {
+		return "", false
+	}
+	return ModelFieldPath(modelPath), true
+}
+
+func (i *EntityInclude) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		i.From = ModelFieldPath(value.Value)
+		return nil
+	}
+	type includeFields EntityInclude
+	var fields includeFields
+	if decodeErr := value.Decode(&fields); decodeErr != nil {
+		return decodeErr
+	}
+	*i = EntityInclude(fields)
+	return nil
+}
+
+// EntityIncludes accepts a single include or a list of includes
+type EntityIncludes []EntityInclude
+
+func (i *EntityIncludes) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		var include EntityInclude
+		if decodeErr := value.Decode(&include); decodeErr != nil {
+			return decodeErr
+		}
+		*i = EntityIncludes{include}
+		return nil
+	}
+	var includes []EntityInclude
+	if decodeErr := value.Decode(&includes); decodeErr != nil {
+		return decodeErr
+	}
+	*i = includes
+	return nil
+}
+
+// ExpandIncludes returns the entity with the fields of all included models added as plain entity fields.
+// Explicitly declared fields take precedence over included ones, and the includes are kept for tooling.
+// Fields included through a relation that is not a required For relation lose the mandatory attribute, since the related model can be missing.
+func (e Entity) ExpandIncludes(allModels map[string]Model) (Entity, error) {
+	if len(e.Includes) == 0 {
+		return e, nil
+	}
+
+	expanded := e.DeepClone()
+	if expanded.Fields == nil {
+		expanded.Fields = map[string]EntityField{}
+	}
+	includedFrom := map[string]ModelFieldPath{}
+	for _, include := range e.Includes {
+		resolved, modelErr := e.resolveIncludeModel(include, allModels)
+		if modelErr != nil {
+			return Entity{}, modelErr
+		}
+		modelPath := resolved.Path
+		model := resolved.TerminalModel()
+		isAlwaysPresent := isRequiredModelPath(resolved)
+
+		for _, excludedName := range include.Exclude {
+			if _, exists := model.Fields[excludedName]; !exists {
+				return Entity{}, ErrUnknownMorpheEntityIncludeField(e.Name, include.From, excludedName)
+			}
+		}
+		for _, renamedName := range core.MapKeysSorted(include.Rename) {
+			if _, exists := model.Fields[renamedName]; !exists {
+				return Entity{}, ErrUnknownMorpheEntityIncludeField(e.Name, include.From, renamedName)
+			}
+		}
+
+		for _, modelFieldName := range core.MapKeysSorted(model.Fields) {
+			if slices.Contains(include.Exclude, modelFieldName) {
+				continue
+			}
+			fieldName := modelFieldName
+			if renamedTo, isRenamed := include.Rename[modelFieldName]; isRenamed {
+				fieldName = renamedTo
+			}
+			if otherFrom, conflict := includedFrom[fieldName]; conflict {
+				return Entity{}, ErrMorpheEntityIncludeFieldConflict(e.Name, fieldName, include.From, otherFrom)
+			}
+			includedFrom[fieldName] = include.From
+
+			if _, isDeclared := e.Fields[fieldName]; isDeclared {
+				continue
+			}
+			attributes := clone.Slice(model.Fields[modelFieldName].Attributes)
+			if !isAlwaysPresent {
+				attributes = getOptionalAttributes(attributes)
+			}
+			expanded.Fields[fieldName] = EntityField{
+				Type:       modelPath + ModelFieldPath("."+modelFieldName),
+				Attributes: attributes,
+			}
+		}
+	}
+	return expanded, nil
+}
+
+func (e Entity) resolveIncludeModel(include EntityInclude, allModels map[string]Model) (ResolvedModelFieldPath, error) {
+	modelPath, isValid := include.GetModelPath()
+	if !isValid {
+		return ResolvedModelFieldPath{}, ErrInvalidMorpheEntityInclude(e.Name, include.From)
+	}
+
+	pathSegments := e.parseFieldTypePath(modelPath)
+	rootModel, rootModelErr := e.resolveRootModel(pathSegments[0], string(include.From), allModels)
+	if rootModelErr != nil {
+		return ResolvedModelFieldPath{}, rootModelErr
+	}
+	resolved := ResolvedModelFieldPath{Path: modelPath}
+	modelPathErr := e.resolveModelFieldPath(&resolved, rootModel, pathSegments[1:], string(include.From), include.From, false, allModels)
+	if modelPathErr != nil {
+		return ResolvedModelFieldPath{}, modelPathErr
+	}
+	return resolved, nil
+}
+
+// getOptionalAttributes drops the mandatory attribute of a field that can be missing
+func getOptionalAttributes(attributes []string) []string {
+	var optional []string
+	for _, attribute := range attributes {
+		if attribute != "mandatory" {
+			optional = append(optional, attribute)
+		}
+	}
+	return optional
+}
+
+// isRequiredModelPath reports if every hop of the path is a required For relation, so the terminal model is always present
+func isRequiredModelPath(resolved ResolvedModelFieldPath) bool {
+	for _, hop := range resolved.Hops {
+		if !strings.HasPrefix(hop.Relation.Type, "For") || !hop.Relation.Required {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func getIncludeTestModels() map[string]Model {
+	return map[string]Model{
+		"Person": {
+			Name: "Person",
+			Fields: map[string]ModelField{
+				"ID":        {Type: ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+				"FirstName": {Type: ModelFieldTypeString},
+				"LastName":  {Type: ModelFieldTypeString},
+			},
+			Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+			Related: map[string]ModelRelation{
+				"ContactInfo": {Type: "HasOne"},
+				"Orders":      {Type: "HasMany", Aliased: "ContactInfo"},
+				"Company":     {Type: "ForOne"},
+				"Employer":    {Type: "ForOne", Aliased: "Company", Required: true},
+			},
+		},
+		"Company": {
+			Name: "Company",
+			Fields: map[string]ModelField{
+				"ID":   {Type: ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+				"Name": {Type: ModelFieldTypeString, Attributes: []string{"mandatory", "searchable"}},
+			},
+			Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+		},
+		"ContactInfo": {
+			Name: "ContactInfo",
+			Fields: map[string]ModelField{
+				"ID":    {Type: ModelFieldTypeAutoIncrement},
+				"Email": {Type: ModelFieldTypeString, Attributes: []string{"mandatory"}},
+			},
+			Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+			Related:     map[string]ModelRelation{"Person": {Type: "ForOne"}},
+		},
+	}
+}
+
+func TestEntityIncludes_Unmarshal(t *testing.T) {
+	entityYAML := `
+name: Person
+include:
+  - Person.*
+  - from: Person.ContactInfo.*
+    exclude: [ID]
+    rename: {Email: ContactEmail}
+`
+	var entity Entity
+	require.NoError(t, yaml.Unmarshal([]byte(entityYAML), &entity))
+
+	assert.Equal(t, EntityIncludes{
+		{From: "Person.*"},
+		{From: "Person.ContactInfo.*", Exclude: []string{"ID"}, Rename: map[string]string{"Email": "ContactEmail"}},
+	}, entity.Includes)
+
+	var single Entity
+	require.NoError(t, yaml.Unmarshal([]byte("name: Person\ninclude: Person.*\n"), &single))
+	assert.Equal(t, EntityIncludes{{From: "Person.*"}}, single.Includes)
+}
+
+func TestEntityInclude_GetModelPath(t *testing.T) {
+	modelPath, isValid := EntityInclude{From: "Person.ContactInfo.*"}.GetModelPath()
+	assert.True(t, isValid)
+	assert.Equal(t, ModelFieldPath("Person.ContactInfo"), modelPath)
+
+	for _, from := range []ModelFieldPath{"Person", "Person.FirstName", ".*", "Person..*"} {
+		_, isValid = EntityInclude{From: from}.GetModelPath()
+		assert.False(t, isValid, from)
+	}
+}
+
+func TestEntity_ExpandIncludes(t *testing.T) {
+	entity := Entity{
+		Name: "Person",
+		Includes: EntityIncludes{
+			{From: "Person.*", Exclude: []string{"LastName"}},
+			{From: "Person.ContactInfo.*", Exclude: []string{"ID"}, Rename: map[string]string{"Email": "ContactEmail"}},
+		},
+		Fields: map[string]EntityField{
+			"ID": {Type: "Person.ID", Attributes: []string{"immutable"}},
+		},
+	}
+
+	expanded, expandErr := entity.ExpandIncludes(getIncludeTestModels())
+
+	require.NoError(t, expandErr)
+	assert.Equal(t, []string{"ContactEmail", "FirstName", "ID"}, core.MapKeysSorted(expanded.Fields))
+	assert.Equal(t, EntityField{Type: "Person.ContactInfo.Email"}, expanded.Fields["ContactEmail"])
+	assert.Equal(t, []string{"immutable"}, expanded.Fields["ID"].Attributes)
+	assert.Equal(t, entity.Includes, expanded.Includes)
+	assert.Len(t, entity.Fields, 1)
+
+	expandedAgain, expandAgainErr := expanded.ExpandIncludes(getIncludeTestModels())
+	require.NoError(t, expandAgainErr)
+	assert.Equal(t, expanded, expandedAgain)
+}
+
+func TestEntity_ExpandIncludes_OptionalRelation(t *testing.T) {
+	optional := Entity{Name: "Person", Includes: EntityIncludes{{From: "Person.Company.*"}}}
+	required := Entity{Name: "Person", Includes: EntityIncludes{{From: "Person.Employer.*"}}}
+	hasOne := Entity{Name: "Person", Includes: EntityIncludes{{From: "Person.Employer.*"}, {From: "Person.ContactInfo.*", Exclude: []string{"ID"}}}}
+	allModels := getIncludeTestModels()
+
+	expandedOptional, optionalErr := optional.ExpandIncludes(allModels)
+	expandedRequired, requiredErr := required.ExpandIncludes(allModels)
+	expandedHasOne, hasOneErr := hasOne.ExpandIncludes(allModels)
+
+	require.NoError(t, optionalErr)
+	require.NoError(t, requiredErr)
+	require.NoError(t, hasOneErr)
+	assert.Equal(t, []string{"searchable"}, expandedOptional.Fields["Name"].Attributes)
+	assert.Empty(t, expandedOptional.Fields["ID"].Attributes)
+	assert.Equal(t, []string{"mandatory", "searchable"}, expandedRequired.Fields["Name"].Attributes)
+	assert.Equal(t, []string{"mandatory"}, expandedRequired.Fields["ID"].Attributes)
+	assert.Equal(t, EntityField{Type: "Person.ContactInfo.Email"}, expandedHasOne.Fields["Email"])
+	assert.Equal(t, []string{"mandatory", "searchable"}, allModels["Company"].Fields["Name"].Attributes)
+}
+
+func TestEntity_ExpandIncludes_Errors(t *testing.T) {
+	testCases := []struct {
+		include  EntityInclude
+		expected string
+	}{
+		{EntityInclude{From: "Person"}, "morphe entity Person include Person must be a model path ending in '.*'"},
+		{EntityInclude{From: "Account.*"}, "morphe entity Person field Account.* references unknown root model: Account"},
+		{EntityInclude{From: "Person.Orders.*"}, "morphe entity Person field Person.Orders.* cannot traverse through to-many relationship Orders"},
+		{EntityInclude{From: "Person.*", Exclude: []string{"Age"}}, "morphe entity Person include Person.* references unknown field: Age"},
+		{EntityInclude{From: "Person.*", Rename: map[string]string{"Age": "Years"}}, "morphe entity Person include Person.* references unknown field: Age"},
+	}
+	for _, testCase := range testCases {
+		entity := Entity{Name: "Person", Includes: EntityIncludes{testCase.include}}
+		_, expandErr := entity.ExpandIncludes(getIncludeTestModels())
+		assert.ErrorContains(t, expandErr, testCase.expected)
+	}
+}
+
+func TestEntity_ExpandIncludes_Conflict(t *testing.T) {
+	entity := Entity{
+		Name:     "Person",
+		Includes: EntityIncludes{{From: "Person.*"}, {From: "Person.ContactInfo.*"}},
+	}
+
+	_, expandErr := entity.ExpandIncludes(getIncludeTestModels())
+
+	assert.ErrorContains(t, expandErr, "morphe entity Person field ID is included by both Person.* and Person.ContactInfo.*")
+}
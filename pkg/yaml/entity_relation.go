@@ -3,17 +3,19 @@ package yaml
 import "github.com/kalo-build/clone"
 
 type EntityRelation struct {
-	Type    string   `yaml:"type"`
-	For     []string `yaml:"for,omitempty"`
-	Through string   `yaml:"through,omitempty"`
-	Aliased string   `yaml:"aliased,omitempty"`
+	Type       string       `yaml:"type"`
+	For        []string     `yaml:"for,omitempty"`
+	Through    string       `yaml:"through,omitempty"`
+	Aliased    string       `yaml:"aliased,omitempty"`
+	Deprecated *Deprecation `yaml:"deprecated,omitempty"`
 }
 
 func (f EntityRelation) DeepClone() EntityRelation {
 	return EntityRelation{
-		Type:    f.Type,
-		For:     clone.Slice(f.For),
-		Through: f.Through,
-		Aliased: f.Aliased,
+		Type:       f.Type,
+		For:        clone.Slice(f.For),
+		Through:    f.Through,
+		Aliased:    f.Aliased,
+		Deprecated: f.Deprecated.DeepClone(),
 	}
 }
@@ -992,3 +992,599 @@ func TestEntityValidate_AliasedFieldPath_NonPolyMalformedAlias(t *testing.T) {
 	assert.Contains(t, err.Error(), "aliased target model .Contact")
 	assert.Contains(t, err.Error(), "does not exist")
 }
+
+func fieldPathTestModels() (map[string]Model, map[string]Enum, map[string]Structure) {
+	allModels := map[string]Model{
+		"Company": {
+			Name: "Company",
+			Fields: map[string]ModelField{
+				"ID":      {Type: "AutoIncrement"},
+				"Name":    {Type: "String"},
+				"Address": {Type: "Address"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+			Related: map[string]ModelRelation{
+				"Employees": {Type: "HasMany", Aliased: "Person"},
+			},
+		},
+		"Person": {
+			Name: "Person",
+			Fields: map[string]ModelField{
+				"ID":          {Type: "AutoIncrement"},
+				"Name":        {Type: "String"},
+				"Nationality": {Type: "Nationality"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+			Related: map[string]ModelRelation{
+				"Employer":    {Type: "ForOne", Aliased: "Company"},
+				"ContactInfo": {Type: "HasOne"},
+			},
+		},
+		"ContactInfo": {
+			Name: "ContactInfo",
+			Fields: map[string]ModelField{
+				"ID":    {Type: "AutoIncrement"},
+				"Email": {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+			Related: map[string]ModelRelation{
+				"Person": {Type: "ForOne"},
+			},
+		},
+	}
+	allEnums := map[string]Enum{
+		"Nationality": {
+			Name:    "Nationality",
+			Type:    EnumTypeString,
+			Entries: map[string]any{"US": "American"},
+		},
+	}
+	allStructures := map[string]Structure{
+		"Address": {
+			Name: "Address",
+			Fields: map[string]StructureField{
+				"Street": {Type: "String"},
+			},
+		},
+	}
+	return allModels, allEnums, allStructures
+}
+
+func TestEntityResolveFieldPath_RootPrimitive(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"Name": {Type: "Person.Name"},
+		},
+	}
+
+	resolved, err := personEntity.ResolveFieldPath("Name", allModels, allEnums, allStructures)
+	require.NoError(t, err)
+
+	assert.Equal(t, ModelFieldPath("Person.Name"), resolved.Path)
+	assert.Len(t, resolved.Models, 1)
+	assert.Empty(t, resolved.Hops)
+	assert.Equal(t, "Person", resolved.RootModel().Name)
+	assert.Equal(t, "Person", resolved.TerminalModel().Name)
+	assert.Equal(t, "Name", resolved.TerminalFieldName)
+	assert.Equal(t, ModelFieldPathTypeKindPrimitive, resolved.TypeKind)
+	assert.Equal(t, ModelFieldTypeString, resolved.Primitive)
+	assert.Nil(t, resolved.Enum)
+	assert.Nil(t, resolved.Structure)
+	assert.False(t, resolved.IsToMany())
+}
+
+func TestEntityResolveFieldPath_AliasedTraversal(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"EmployerAddress": {Type: "Person.Employer.Address"},
+			"Email":           {Type: "Person.ContactInfo.Email"},
+			"Nationality":     {Type: "Person.Nationality"},
+		},
+	}
+
+	addressResolved, addressErr := personEntity.ResolveFieldPath("EmployerAddress", allModels, allEnums, allStructures)
+	require.NoError(t, addressErr)
+
+	require.Len(t, addressResolved.Hops, 1)
+	hop := addressResolved.Hops[0]
+	assert.Equal(t, "Employer", hop.RelationName)
+	assert.Equal(t, "ForOne", hop.Relation.Type)
+	assert.Equal(t, "Person", hop.FromModel)
+	assert.Equal(t, "Company", hop.ToModel)
+	assert.Equal(t, RelationCardinalityOne, hop.Cardinality)
+
+	require.Len(t, addressResolved.Models, 2)
+	assert.Equal(t, "Company", addressResolved.TerminalModel().Name)
+	assert.Equal(t, ModelFieldPathTypeKindStructure, addressResolved.TypeKind)
+	require.NotNil(t, addressResolved.Structure)
+	assert.Equal(t, "Address", addressResolved.Structure.Name)
+
+	emailResolved, emailErr := personEntity.ResolveFieldPath("Email", allModels, allEnums, allStructures)
+	require.NoError(t, emailErr)
+	assert.Equal(t, "ContactInfo", emailResolved.TerminalModel().Name)
+	assert.Equal(t, ModelFieldTypeString, emailResolved.Primitive)
+
+	nationalityResolved, nationalityErr := personEntity.ResolveFieldPath("Nationality", allModels, allEnums, allStructures)
+	require.NoError(t, nationalityErr)
+	assert.Equal(t, ModelFieldPathTypeKindEnum, nationalityResolved.TypeKind)
+	require.NotNil(t, nationalityResolved.Enum)
+	assert.Equal(t, "Nationality", nationalityResolved.Enum.Name)
+}
+
+func TestEntityResolveFieldPath_ToManyTraversal(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	companyEntity := Entity{
+		Name: "Company",
+		Fields: map[string]EntityField{
+			"EmployeeName": {Type: "Company.Employees.Name"},
+		},
+	}
+
+	_, err := companyEntity.ResolveFieldPath("EmployeeName", allModels, allEnums, allStructures)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field EmployeeName cannot traverse through to-many relationship Employees in path Company.Employees")
+}
+
+func TestEntityValidate_ToManyTraversal_NestedHop(t *testing.T) {
+	allModels, allEnums, _ := fieldPathTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"ID":           {Type: "Person.ID"},
+			"CoworkerName": {Type: "Person.Employer.Employees.Name"},
+			"EmployerName": {Type: "Person.Employer.Name"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, allEnums)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field CoworkerName cannot traverse through to-many relationship Employees in path Person.Employer.Employees")
+}
+
+func TestEntityResolveFieldPath_UnknownField(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"Name": {Type: "Person.Name"},
+		},
+	}
+
+	_, err := personEntity.ResolveFieldPath("Age", allModels, allEnums, allStructures)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no field Age")
+}
+
+func TestEntityResolveFieldPath_UnknownStructureWithoutStructures(t *testing.T) {
+	allModels, allEnums, _ := fieldPathTestModels()
+	companyEntity := Entity{
+		Name: "Company",
+		Fields: map[string]EntityField{
+			"Address": {Type: "Company.Address"},
+		},
+	}
+
+	_, err := companyEntity.ResolveFieldPath("Address", allModels, allEnums, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown non-primitive type 'Address'")
+}
+
+func TestEntityResolveAllFieldPaths(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"ID":    {Type: "Person.ID"},
+			"Email": {Type: "Person.ContactInfo.Email"},
+		},
+	}
+
+	allResolved, err := personEntity.ResolveAllFieldPaths(allModels, allEnums, allStructures)
+	require.NoError(t, err)
+	assert.Len(t, allResolved, 2)
+	assert.Equal(t, "ID", allResolved["ID"].TerminalFieldName)
+	assert.Equal(t, "Email", allResolved["Email"].TerminalFieldName)
+}
+
+func identifierTestModels() map[string]Model {
+	return map[string]Model{
+		"Person": {
+			Name: "Person",
+			Fields: map[string]ModelField{
+				"ID":        {Type: "AutoIncrement"},
+				"UUID":      {Type: "UUID"},
+				"FirstName": {Type: "String"},
+				"LastName":  {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+				"entity":  {Fields: []string{"UUID"}},
+				"name":    {Fields: []string{"FirstName", "LastName"}},
+			},
+			Related: map[string]ModelRelation{
+				"ContactInfo": {Type: "HasOne"},
+				"Pets":        {Type: "HasMany", Aliased: "Pet"},
+				"Company":     {Type: "ForOne"},
+			},
+		},
+		"Company": {
+			Name: "Company",
+			Fields: map[string]ModelField{
+				"ID":   {Type: "AutoIncrement"},
+				"Name": {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+				"name":    {Fields: []string{"Name"}},
+			},
+		},
+		"ContactInfo": {
+			Name: "ContactInfo",
+			Fields: map[string]ModelField{
+				"ID":    {Type: "AutoIncrement"},
+				"Email": {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+				"email":   {Fields: []string{"Email"}},
+			},
+		},
+		"Pet": {
+			Name: "Pet",
+			Fields: map[string]ModelField{
+				"ID":   {Type: "AutoIncrement"},
+				"Name": {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+		},
+	}
+}
+
+func TestEntityValidate_Identifiers_MapToModelIdentifiers(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":      {Type: "Person.UUID"},
+			"FirstName": {Type: "Person.FirstName"},
+			"LastName":  {Type: "Person.LastName"},
+			"Email":     {Type: "Person.ContactInfo.Email"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"UUID"}},
+			"name":    {Fields: []string{"FirstName", "LastName"}},
+			"email":   {Fields: []string{"Email"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	assert.NoError(t, err)
+}
+
+func TestEntityValidate_Identifiers_NotAModelIdentifier(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":      {Type: "Person.UUID"},
+			"FirstName": {Type: "Person.FirstName"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"FirstName"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "identifier 'primary' fields [FirstName] do not form an identifier of model 'Person'")
+}
+
+func TestEntityValidate_Identifiers_PartialCompositeIdentifier(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":     {Type: "Person.UUID"},
+			"LastName": {Type: "Person.LastName"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"UUID"}},
+			"name":    {Fields: []string{"LastName"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "identifier 'name' fields [LastName] do not form an identifier of model 'Person'")
+}
+
+func TestEntityValidate_Identifiers_MixedModelPaths(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":  {Type: "Person.UUID"},
+			"Email": {Type: "Person.ContactInfo.Email"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"UUID", "Email"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spans fields of different model paths: Person and Person.ContactInfo")
+}
+
+func TestEntityValidate_Identifiers_ToManyTraversal(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":  {Type: "Person.UUID"},
+			"PetID": {Type: "Person.Pets.ID"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"UUID"}},
+			"pet":     {Fields: []string{"PetID"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field PetID cannot traverse through to-many relationship Pets in path Person.Pets")
+}
+
+func TestEntityValidate_Identifiers_ForOneTraversal(t *testing.T) {
+	allModels := identifierTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"UUID":        {Type: "Person.UUID"},
+			"CompanyName": {Type: "Person.Company.Name"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"UUID"}},
+			"company": {Fields: []string{"CompanyName"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity 'Person' identifier 'company' field 'CompanyName' traverses ForOne relation 'Company', only HasOne relations keep identifier values unique")
+}
+
+func expressionTestModels() map[string]Model {
+	return map[string]Model{
+		"Person": {
+			Name: "Person",
+			Fields: map[string]ModelField{
+				"ID":        {Type: "AutoIncrement"},
+				"FirstName": {Type: "String"},
+				"LastName":  {Type: "String"},
+				"Password":  {Type: "Protected"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+			Related: map[string]ModelRelation{
+				"Orders":      {Type: "HasMany", Aliased: "Order"},
+				"ContactInfo": {Type: "HasOne"},
+			},
+		},
+		"ContactInfo": {
+			Name: "ContactInfo",
+			Fields: map[string]ModelField{
+				"ID":    {Type: "AutoIncrement"},
+				"Email": {Type: "String"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+		},
+		"Order": {
+			Name: "Order",
+			Fields: map[string]ModelField{
+				"ID":       {Type: "AutoIncrement"},
+				"Total":    {Type: "Float"},
+				"Quantity": {Type: "Integer"},
+				"PlacedAt": {Type: "Time"},
+				"IsPaid":   {Type: "Boolean"},
+			},
+			Identifiers: map[string]ModelIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+		},
+	}
+}
+
+func TestEntityResolveFieldExpression_ResultTypes(t *testing.T) {
+	allModels := expressionTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"ID":            {Type: "Person.ID"},
+			"OrderCount":    {Type: "count(Person.Orders)"},
+			"OrderTotal":    {Type: "sum(Person.Orders.Total)"},
+			"OrderQuantity": {Type: "sum(Person.Orders.Quantity)"},
+			"AvgQuantity":   {Type: "avg(Person.Orders.Quantity)"},
+			"FirstOrderAt":  {Type: "min(Person.Orders.PlacedAt)"},
+			"LargestOrder":  {Type: "max(Person.Orders.Total)"},
+			"FullName":      {Type: `concat(Person.FirstName, " ", Person.LastName)`},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+
+	expectedTypes := map[string]ModelFieldType{
+		"ID":            ModelFieldTypeAutoIncrement,
+		"OrderCount":    ModelFieldTypeInteger,
+		"OrderTotal":    ModelFieldTypeFloat,
+		"OrderQuantity": ModelFieldTypeInteger,
+		"AvgQuantity":   ModelFieldTypeFloat,
+		"FirstOrderAt":  ModelFieldTypeTime,
+		"LargestOrder":  ModelFieldTypeFloat,
+		"FullName":      ModelFieldTypeString,
+	}
+	for fieldName, expectedType := range expectedTypes {
+		resolved, err := personEntity.ResolveFieldExpression(fieldName, allModels, map[string]Enum{}, nil)
+		require.NoError(t, err, fieldName)
+		assert.Equal(t, expectedType, resolved.Type, fieldName)
+	}
+
+	validateErr := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	assert.NoError(t, validateErr)
+}
+
+func TestEntityResolveFieldExpression_Traversals(t *testing.T) {
+	allModels := expressionTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"OrderCount": {Type: "count(Person.Orders)"},
+			"Contact":    {Type: `concat(Person.FirstName, " <", Person.ContactInfo.Email, ">")`},
+		},
+	}
+
+	countResolved, countErr := personEntity.ResolveFieldExpression("OrderCount", allModels, map[string]Enum{}, nil)
+	require.NoError(t, countErr)
+	require.Len(t, countResolved.Paths, 1)
+	require.Len(t, countResolved.Paths[0].Hops, 1)
+	assert.Equal(t, RelationCardinalityMany, countResolved.Paths[0].Hops[0].Cardinality)
+	assert.Equal(t, "Order", countResolved.Paths[0].TerminalModel().Name)
+	assert.Empty(t, countResolved.Paths[0].TerminalFieldName)
+
+	concatResolved, concatErr := personEntity.ResolveFieldExpression("Contact", allModels, map[string]Enum{}, nil)
+	require.NoError(t, concatErr)
+	require.Len(t, concatResolved.Paths, 2)
+	assert.Equal(t, "FirstName", concatResolved.Paths[0].TerminalFieldName)
+	assert.Equal(t, "ContactInfo", concatResolved.Paths[1].TerminalModel().Name)
+}
+
+func TestEntityResolveFieldExpression_Invalid(t *testing.T) {
+	allModels := expressionTestModels()
+	testCases := []struct {
+		fieldType ModelFieldPath
+		reason    string
+	}{
+		{"count(Person.ContactInfo)", "path Person.ContactInfo does not traverse a to-many relationship"},
+		{"max(Person.FirstName)", "path Person.FirstName does not traverse a to-many relationship"},
+		{"sum(Person.Orders.PlacedAt)", "path Person.Orders.PlacedAt has unsupported type 'Time'"},
+		{"avg(Person.Orders.IsPaid)", "path Person.Orders.IsPaid has unsupported type 'Boolean'"},
+		{"max(Person.Orders.IsPaid)", "has unsupported type 'Boolean'"},
+		{"count(Person.Invoices)", "unknown related model: Invoices"},
+		{"sum(Person.Orders.Discount)", "unknown terminal field: Discount"},
+		{`concat(Person.FirstName, Person.Password)`, "path Person.Password has unsupported type 'Protected'"},
+		{`concat(Person.FirstName, Person.Orders.Total)`, "cannot traverse through to-many relationship Orders"},
+		{"avg(Person.Orders.Total", "has an invalid expression"},
+	}
+
+	for _, testCase := range testCases {
+		personEntity := Entity{
+			Name: "Person",
+			Fields: map[string]EntityField{
+				"ID":       {Type: "Person.ID"},
+				"Computed": {Type: testCase.fieldType},
+			},
+			Identifiers: map[string]EntityIdentifier{
+				"primary": {Fields: []string{"ID"}},
+			},
+		}
+
+		err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+		require.Error(t, err, testCase.fieldType)
+		assert.Contains(t, err.Error(), testCase.reason, testCase.fieldType)
+	}
+}
+
+func TestEntityValidate_Identifiers_ComputedField(t *testing.T) {
+	allModels := expressionTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"ID":         {Type: "Person.ID"},
+			"OrderCount": {Type: "count(Person.Orders)"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+			"orders":  {Fields: []string{"OrderCount"}},
+		},
+	}
+
+	err := personEntity.Validate(map[string]Entity{"Person": personEntity}, allModels, map[string]Enum{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "identifier 'orders' references computed field 'OrderCount'")
+}
+
+func TestEntityResolveFieldPath_ComputedField(t *testing.T) {
+	allModels := expressionTestModels()
+	personEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"ID":         {Type: "Person.ID"},
+			"OrderCount": {Type: "count(Person.Orders)"},
+		},
+	}
+
+	_, err := personEntity.ResolveFieldPath("OrderCount", allModels, map[string]Enum{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is computed and has no single model field path")
+
+	allResolved, allErr := personEntity.ResolveAllFieldPaths(allModels, map[string]Enum{}, nil)
+	require.NoError(t, allErr)
+	assert.Len(t, allResolved, 1)
+	assert.Contains(t, allResolved, "ID")
+}
+
+func TestEntityResolveRootModelName(t *testing.T) {
+	allModels, allEnums, allStructures := fieldPathTestModels()
+	identifiedEntity := Entity{
+		Name: "Person",
+		Fields: map[string]EntityField{
+			"EmployerName": {Type: "Company.Name"},
+			"ID":           {Type: "Person.ID"},
+		},
+		Identifiers: map[string]EntityIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+	unidentifiedEntity := Entity{
+		Name: "Company",
+		Fields: map[string]EntityField{
+			"Employees": {Type: "count(Company.Employees)"},
+			"Name":      {Type: "Company.Name"},
+		},
+	}
+	literalEntity := Entity{
+		Name: "Greeting",
+		Fields: map[string]EntityField{
+			"Text": {Type: "concat('Hello', 'World')"},
+		},
+	}
+
+	identifiedRoot, identifiedErr := identifiedEntity.ResolveRootModelName(allModels, allEnums, allStructures)
+	unidentifiedRoot, unidentifiedErr := unidentifiedEntity.ResolveRootModelName(allModels, allEnums, allStructures)
+	_, literalErr := literalEntity.ResolveRootModelName(allModels, allEnums, allStructures)
+
+	require.NoError(t, identifiedErr)
+	require.NoError(t, unidentifiedErr)
+	assert.Equal(t, "Person", identifiedRoot)
+	assert.Equal(t, "Company", unidentifiedRoot)
+	assert.ErrorContains(t, literalErr, "morphe entity Greeting field Text does not reference a root model")
+}
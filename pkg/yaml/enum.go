@@ -1,21 +1,36 @@
 package yaml
 
 import (
-	"fmt"
-
+	"github.com/kalo-build/clone"
 	"github.com/kalo-build/go-util/core"
 )
 
 type Enum struct {
-	Name    string         `yaml:"name"`
-	Type    EnumType       `yaml:"type"`
-	Entries map[string]any `yaml:"entries"`
+	Name      string         `yaml:"name"`
+	Namespace string         `yaml:"namespace,omitempty"`
+	Type      EnumType       `yaml:"type"`
+	Kind      EnumKind       `yaml:"kind,omitempty"`
+	Entries   map[string]any `yaml:"entries"`
+
+	// EntryOrder holds the entry names in declaration order, see GetEntryNames
+	EntryOrder []string `yaml:"-"`
+
+	// EntryDetails holds the labels and descriptions of entries declared in the extended form
+	EntryDetails map[string]EnumEntryDetails `yaml:"-"`
+
+	Deprecated *Deprecation `yaml:"deprecated,omitempty"`
+
+	// DeprecatedEntries holds the deprecations of entries declared in the extended form, by entry name
+	DeprecatedEntries map[string]Deprecation `yaml:"-"`
 }
 
 func (e Enum) Validate() error {
 	if e.Name == "" {
 		return ErrNoMorpheEnumName
 	}
+	if !IsValidDefinitionName(e.Name) {
+		return ErrMorpheDefinitionNameNotCapitalized("enum", e.Name)
+	}
 	if e.Type == "" {
 		return ErrNoMorpheEnumType
 	}
@@ -28,13 +43,38 @@ func (e Enum) Validate() error {
 		return entryTypesErr
 	}
 
+	uniqueValuesErr := e.validateUniqueEntryValues()
+	if uniqueValuesErr != nil {
+		return uniqueValuesErr
+	}
+
+	kindErr := e.validateKind()
+	if kindErr != nil {
+		return kindErr
+	}
+
+	for _, entryName := range core.MapKeysSorted(e.DeprecatedEntries) {
+		if _, exists := e.Entries[entryName]; !exists {
+			return ErrMorpheEnumUnknownDeprecatedEntry(e.Name, entryName)
+		}
+	}
+	for _, entryName := range core.MapKeysSorted(e.EntryDetails) {
+		if _, exists := e.Entries[entryName]; !exists {
+			return ErrMorpheEnumUnknownEntryDetails(e.Name, entryName)
+		}
+	}
+
 	return nil
 }
 
 func (e Enum) DeepClone() Enum {
 	enumCopy := Enum{
-		Name: e.Name,
-		Type: e.Type,
+		Name:       e.Name,
+		Namespace:  e.Namespace,
+		Type:       e.Type,
+		Kind:       e.Kind,
+		EntryOrder: clone.Slice(e.EntryOrder),
+		Deprecated: e.Deprecated.DeepClone(),
 	}
 
 	entriesCopy := make(map[string]any, len(e.Entries))
@@ -44,6 +84,20 @@ func (e Enum) DeepClone() Enum {
 
 	enumCopy.Entries = entriesCopy
 
+	if e.EntryDetails != nil {
+		enumCopy.EntryDetails = make(map[string]EnumEntryDetails, len(e.EntryDetails))
+		for entryName, details := range e.EntryDetails {
+			enumCopy.EntryDetails[entryName] = details
+		}
+	}
+
+	if e.DeprecatedEntries != nil {
+		enumCopy.DeprecatedEntries = make(map[string]Deprecation, len(e.DeprecatedEntries))
+		for entryName, deprecation := range e.DeprecatedEntries {
+			enumCopy.DeprecatedEntries[entryName] = deprecation
+		}
+	}
+
 	return enumCopy
 }
 
@@ -61,7 +115,7 @@ func (e Enum) validateAllEntryTypes() error {
 
 func (e Enum) validateEnumEntryValueType(entryName string, entryValue any) error {
 	if e.Type != EnumTypeString && e.Type != EnumTypeInteger && e.Type != EnumTypeFloat {
-		return fmt.Errorf("enum type '%s' is not supported", e.Type)
+		return ErrMorpheEnumUnsupportedType(e.Name, e.Type)
 	}
 
 	isString := false
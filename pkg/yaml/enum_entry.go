@@ -0,0 +1,219 @@
+package yaml
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnumEntryDetails holds the optional display metadata of an enum entry
+type EnumEntryDetails struct {
+	Label       string `yaml:"label,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// enumEntryExtended is the extended entry form, such as 'US: {value: American, label: United States}'
+type enumEntryExtended struct {
+	Value       any          `yaml:"value"`
+	Label       string       `yaml:"label"`
+	Description string       `yaml:"description"`
+	Deprecated  *Deprecation `yaml:"deprecated"`
+}
+
+// UnmarshalYAML keeps the declaration order of the entries and accepts the extended entry form next to plain values.
+// Deprecations in the extended form are collected into DeprecatedEntries.
+func (e *Enum) UnmarshalYAML(value *yaml.Node) error {
+	type enumFields Enum
+	var fields enumFields
+	if decodeErr := value.Decode(&fields); decodeErr != nil {
+		return decodeErr
+	}
+	*e = Enum(fields)
+
+	entriesNode := getMappingValueNode(value, "entries")
+	if entriesNode == nil || entriesNode.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	e.EntryOrder = make([]string, 0, len(entriesNode.Content)/2)
+	for i := 0; i+1 < len(entriesNode.Content); i += 2 {
+		entryName := entriesNode.Content[i].Value
+		entryNode := entriesNode.Content[i+1]
+		e.EntryOrder = append(e.EntryOrder, entryName)
+		if entryNode.Kind != yaml.MappingNode {
+			continue
+		}
+
+		var extended enumEntryExtended
+		if decodeErr := entryNode.Decode(&extended); decodeErr != nil {
+			return decodeErr
+		}
+		if extended.Value == nil {
+			return ErrMorpheEnumEntryNoValue(e.Name, entryName)
+		}
+		e.Entries[entryName] = extended.Value
+		if extended.Label != "" || extended.Description != "" {
+			if e.EntryDetails == nil {
+				e.EntryDetails = map[string]EnumEntryDetails{}
+			}
+			e.EntryDetails[entryName] = EnumEntryDetails{Label: extended.Label, Description: extended.Description}
+		}
+		if extended.Deprecated != nil {
+			if e.DeprecatedEntries == nil {
+				e.DeprecatedEntries = map[string]Deprecation{}
+			}
+			e.DeprecatedEntries[entryName] = *extended.Deprecated
+		}
+	}
+	return nil
+}
+
+func getMappingValueNode(mappingNode *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mappingNode.Content); i += 2 {
+		if mappingNode.Content[i].Value == key {
+			return mappingNode.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// GetEntryNames returns the entry names in declaration order, entries without a declared position follow sorted by name
+func (e Enum) GetEntryNames() []string {
+	entryNames := make([]string, 0, len(e.Entries))
+	for _, entryName := range e.EntryOrder {
+		if _, exists := e.Entries[entryName]; exists && !slices.Contains(entryNames, entryName) {
+			entryNames = append(entryNames, entryName)
+		}
+	}
+
+	undeclaredNames := []string{}
+	for entryName := range e.Entries {
+		if !slices.Contains(entryNames, entryName) {
+			undeclaredNames = append(undeclaredNames, entryName)
+		}
+	}
+	sort.Strings(undeclaredNames)
+	return append(entryNames, undeclaredNames...)
+}
+
+// ValueOf returns the value of an entry
+func (e Enum) ValueOf(entryName string) (any, bool) {
+	value, exists := e.Entries[entryName]
+	return value, exists
+}
+
+// KeyFor returns the name of the first entry in declaration order with the specified value, numbers are compared by value
+func (e Enum) KeyFor(value any) (string, bool) {
+	number, isNumber := toEnumNumber(value)
+	for _, entryName := range e.GetEntryNames() {
+		entryValue := e.Entries[entryName]
+		if entryValue == value {
+			return entryName, true
+		}
+		entryNumber, isEntryNumber := toEnumNumber(entryValue)
+		if isNumber && isEntryNumber && number == entryNumber {
+			return entryName, true
+		}
+	}
+	return "", false
+}
+
+// StringValue returns the value of an entry of a String enum
+func (e Enum) StringValue(entryName string) (string, error) {
+	value, exists := e.Entries[entryName]
+	if !exists {
+		return "", ErrMorpheEnumUnknownEntry(e.Name, entryName)
+	}
+	stringValue, isString := value.(string)
+	if !isString {
+		return "", ErrMorpheEnumEntryValueConversion(e.Name, entryName, value, EnumTypeString)
+	}
+	return stringValue, nil
+}
+
+// IntValue returns the value of an entry of an Integer enum, or an integral entry of a Float enum
+func (e Enum) IntValue(entryName string) (int64, error) {
+	value, exists := e.Entries[entryName]
+	if !exists {
+		return 0, ErrMorpheEnumUnknownEntry(e.Name, entryName)
+	}
+	number, isNumber := toEnumNumber(value)
+	if !isNumber || number != math.Trunc(number) || number < math.MinInt64 || number >= math.MaxInt64 {
+		return 0, ErrMorpheEnumEntryValueConversion(e.Name, entryName, value, EnumTypeInteger)
+	}
+	if integerValue, isInteger := value.(int64); isInteger {
+		return integerValue, nil
+	}
+	return int64(number), nil
+}
+
+// FloatValue returns the value of an entry of a Float or Integer enum
+func (e Enum) FloatValue(entryName string) (float64, error) {
+	value, exists := e.Entries[entryName]
+	if !exists {
+		return 0, ErrMorpheEnumUnknownEntry(e.Name, entryName)
+	}
+	number, isNumber := toEnumNumber(value)
+	if !isNumber {
+		return 0, ErrMorpheEnumEntryValueConversion(e.Name, entryName, value, EnumTypeFloat)
+	}
+	return number, nil
+}
+
+// Label returns the display label of an entry, which defaults to the entry name
+func (e Enum) Label(entryName string) string {
+	if label := e.EntryDetails[entryName].Label; label != "" {
+		return label
+	}
+	return entryName
+}
+
+// Description returns the description of an entry, if any
+func (e Enum) Description(entryName string) string {
+	return e.EntryDetails[entryName].Description
+}
+
+// GetEntryDeprecation returns the deprecation of an entry, or nil if it is not deprecated
+func (e Enum) GetEntryDeprecation(entryName string) *Deprecation {
+	deprecation, isDeprecated := e.DeprecatedEntries[entryName]
+	if !isDeprecated {
+		return nil
+	}
+	return &deprecation
+}
+
+func toEnumNumber(value any) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue), true
+	case int8:
+		return float64(typedValue), true
+	case int16:
+		return float64(typedValue), true
+	case int32:
+		return float64(typedValue), true
+	case int64:
+		return float64(typedValue), true
+	case uint:
+		return float64(typedValue), true
+	case uint8:
+		return float64(typedValue), true
+	case uint16:
+		return float64(typedValue), true
+	case uint32:
+		return float64(typedValue), true
+	case uint64:
+		return float64(typedValue), true
+	case float32:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case json.Number:
+		number, parseErr := typedValue.Float64()
+		return number, parseErr == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,132 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEnum_Unmarshal_EntryOrderAndDetails(t *testing.T) {
+	enumYAML := `
+name: Nationality
+type: String
+entries:
+  US:
+    value: American
+    label: United States
+    description: Citizens of the United States
+  DE: German
+  FR:
+    value: French
+    deprecated: {since: "2.3"}
+deprecatedEntries:
+  US: {since: "1.0"}
+`
+	var enum Enum
+	require.NoError(t, yaml.Unmarshal([]byte(enumYAML), &enum))
+
+	assert.Equal(t, []string{"US", "DE", "FR"}, enum.GetEntryNames())
+	assert.Equal(t, map[string]any{"US": "American", "DE": "German", "FR": "French"}, enum.Entries)
+	assert.Equal(t, "United States", enum.Label("US"))
+	assert.Equal(t, "DE", enum.Label("DE"))
+	assert.Equal(t, "Citizens of the United States", enum.Description("US"))
+	assert.Equal(t, "", enum.Description("DE"))
+	require.NotNil(t, enum.GetEntryDeprecation("FR"))
+	assert.Equal(t, "2.3", enum.GetEntryDeprecation("FR").Since)
+	assert.Nil(t, enum.GetEntryDeprecation("US"))
+	assert.NoError(t, enum.Validate())
+}
+
+func TestEnum_Unmarshal_ExtendedEntryNoValue(t *testing.T) {
+	enumYAML := `
+name: Nationality
+type: String
+entries:
+  US: {label: United States}
+`
+	var enum Enum
+	assert.ErrorContains(t, yaml.Unmarshal([]byte(enumYAML), &enum), "enum 'Nationality' entry 'US' has no value")
+}
+
+func TestEnum_GetEntryNames_Undeclared(t *testing.T) {
+	enum := Enum{
+		Name:       "Status",
+		Type:       EnumTypeString,
+		Entries:    map[string]any{"Active": "active", "Archived": "archived", "Draft": "draft"},
+		EntryOrder: []string{"Draft", "Removed"},
+	}
+
+	assert.Equal(t, []string{"Draft", "Active", "Archived"}, enum.GetEntryNames())
+}
+
+func TestEnum_ValueOf_KeyFor(t *testing.T) {
+	enum := Enum{Name: "Priority", Type: EnumTypeInteger, Entries: map[string]any{"Low": 1, "High": 3}}
+
+	value, exists := enum.ValueOf("High")
+	assert.True(t, exists)
+	assert.Equal(t, 3, value)
+	_, exists = enum.ValueOf("Medium")
+	assert.False(t, exists)
+
+	key, found := enum.KeyFor(int64(3))
+	assert.True(t, found)
+	assert.Equal(t, "High", key)
+	key, found = enum.KeyFor(1.0)
+	assert.True(t, found)
+	assert.Equal(t, "Low", key)
+	_, found = enum.KeyFor("1")
+	assert.False(t, found)
+}
+
+func TestEnum_TypedValues(t *testing.T) {
+	enum := Enum{Name: "Mixed", Type: EnumTypeFloat, Entries: map[string]any{"Whole": 2.0, "Fraction": 2.5, "Text": "two"}}
+
+	intValue, intErr := enum.IntValue("Whole")
+	require.NoError(t, intErr)
+	assert.Equal(t, int64(2), intValue)
+	_, intErr = enum.IntValue("Fraction")
+	assert.ErrorContains(t, intErr, "enum 'Mixed' entry 'Fraction' value '2.5' is not a Integer value")
+
+	floatValue, floatErr := enum.FloatValue("Fraction")
+	require.NoError(t, floatErr)
+	assert.Equal(t, 2.5, floatValue)
+
+	stringValue, stringErr := enum.StringValue("Text")
+	require.NoError(t, stringErr)
+	assert.Equal(t, "two", stringValue)
+	_, stringErr = enum.StringValue("Whole")
+	assert.Error(t, stringErr)
+
+	_, unknownErr := enum.IntValue("Missing")
+	assert.ErrorContains(t, unknownErr, "enum 'Mixed' has no entry 'Missing'")
+}
+
+func TestEnum_Validate_UnknownEntryDetails(t *testing.T) {
+	enum := Enum{
+		Name:         "Nationality",
+		Type:         EnumTypeString,
+		Entries:      map[string]any{"US": "American"},
+		EntryDetails: map[string]EnumEntryDetails{"FR": {Label: "France"}},
+	}
+
+	assert.ErrorContains(t, enum.Validate(), "enum 'Nationality' has details for unknown entry 'FR'")
+}
+
+func TestEnum_DeepClone_EntryOrderAndDetails(t *testing.T) {
+	original := Enum{
+		Name:         "Nationality",
+		Type:         EnumTypeString,
+		Entries:      map[string]any{"US": "American", "DE": "German"},
+		EntryOrder:   []string{"US", "DE"},
+		EntryDetails: map[string]EnumEntryDetails{"US": {Label: "United States"}},
+	}
+
+	cloned := original.DeepClone()
+	cloned.EntryOrder[0] = "DE"
+	cloned.EntryDetails["US"] = EnumEntryDetails{Label: "USA"}
+
+	assert.Equal(t, []string{"US", "DE"}, original.EntryOrder)
+	assert.Equal(t, "United States", original.Label("US"))
+}
@@ -16,3 +16,55 @@ func ErrMorpheEnumUnsupportedEntryType(entryName string, entryValue any) error {
 func ErrMorpheEnumEntryTypeMismatch(enumType EnumType, entryName string, entryValue any) error {
 	return fmt.Errorf("enum entry '%s' value '%v' with type '%T' does not match the enum type of '%s'", entryName, entryValue, entryValue, enumType)
 }
+
+func ErrMorpheEnumUnknownDeprecatedEntry(enumName string, entryName string) error {
+	return fmt.Errorf("enum '%s' deprecates unknown entry '%s'", enumName, entryName)
+}
+
+func ErrMorpheEnumEntryNoValue(enumName string, entryName string) error {
+	return fmt.Errorf("enum '%s' entry '%s' has no value", enumName, entryName)
+}
+
+func ErrMorpheEnumUnknownEntry(enumName string, entryName string) error {
+	return fmt.Errorf("enum '%s' has no entry '%s'", enumName, entryName)
+}
+
+func ErrMorpheEnumUnknownEntryDetails(enumName string, entryName string) error {
+	return fmt.Errorf("enum '%s' has details for unknown entry '%s'", enumName, entryName)
+}
+
+func ErrMorpheEnumEntryValueConversion(enumName string, entryName string, entryValue any, targetType EnumType) error {
+	return fmt.Errorf("enum '%s' entry '%s' value '%v' is not a %s value", enumName, entryName, entryValue, targetType)
+}
+
+func ErrMorpheEnumUnsupportedType(enumName string, enumType EnumType) error {
+	return fmt.Errorf("enum '%s' type '%s' is not supported", enumName, enumType)
+}
+
+func ErrMorpheEnumUnsupportedKind(enumName string, enumKind EnumKind) error {
+	return fmt.Errorf("enum '%s' kind '%s' is not supported", enumName, enumKind)
+}
+
+func ErrMorpheEnumKindTypeMismatch(enumName string, enumKind EnumKind, enumType EnumType) error {
+	return fmt.Errorf("enum '%s' of kind '%s' must have type '%s', not '%s'", enumName, enumKind, EnumTypeInteger, enumType)
+}
+
+func ErrMorpheEnumNotKind(enumName string, enumKind EnumKind) error {
+	return fmt.Errorf("enum '%s' is not of kind '%s'", enumName, enumKind)
+}
+
+func ErrMorpheEnumDuplicateEntryValue(enumName string, entryName string, firstEntryName string, entryValue any) error {
+	return fmt.Errorf("enum '%s' entry '%s' value '%v' duplicates the value of entry '%s'", enumName, entryName, entryValue, firstEntryName)
+}
+
+func ErrMorpheEnumFlagNotPowerOfTwo(enumName string, entryName string, entryValue int64) error {
+	return fmt.Errorf("flag enum '%s' entry '%s' value '%d' is not a power of two", enumName, entryName, entryValue)
+}
+
+func ErrMorpheEnumUnknownFlags(enumName string, flags int64) error {
+	return fmt.Errorf("flag enum '%s' has no entries for flags '%d'", enumName, flags)
+}
+
+func ErrMorpheEnumUndeclaredOrderedEntry(enumName string, entryName string) error {
+	return fmt.Errorf("ordered enum '%s' entry '%s' has no declared position", enumName, entryName)
+}
@@ -0,0 +1,154 @@
+package yaml
+
+import (
+	"math/bits"
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+)
+
+// EnumKind describes the semantics of an enum beyond the type of its values, an empty kind is a plain enum
+type EnumKind string
+
+const (
+	// EnumKindFlag enums are bitsets, every Integer entry value is a distinct power of two
+	EnumKindFlag EnumKind = "Flag"
+	// EnumKindOrdered enums rank their entries by declaration order
+	EnumKindOrdered EnumKind = "Ordered"
+)
+
+var EnumKinds = []EnumKind{
+	EnumKindFlag,
+	EnumKindOrdered,
+}
+
+// IsFlag returns true if the enum is a flag enum
+func (e Enum) IsFlag() bool {
+	return e.Kind == EnumKindFlag
+}
+
+// IsOrdered returns true if the enum is an ordered enum
+func (e Enum) IsOrdered() bool {
+	return e.Kind == EnumKindOrdered
+}
+
+// CompareEntries compares the declaration positions of two entries of an ordered enum, returning -1, 0 or 1
+func (e Enum) CompareEntries(entryNameA string, entryNameB string) (int, error) {
+	if !e.IsOrdered() {
+		return 0, ErrMorpheEnumNotKind(e.Name, EnumKindOrdered)
+	}
+	entryNames := e.GetEntryNames()
+	indexA := slices.Index(entryNames, entryNameA)
+	if indexA == -1 {
+		return 0, ErrMorpheEnumUnknownEntry(e.Name, entryNameA)
+	}
+	indexB := slices.Index(entryNames, entryNameB)
+	if indexB == -1 {
+		return 0, ErrMorpheEnumUnknownEntry(e.Name, entryNameB)
+	}
+	switch {
+	case indexA < indexB:
+		return -1, nil
+	case indexA > indexB:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// CombineFlags returns the bitwise union of the values of the specified entries of a flag enum
+func (e Enum) CombineFlags(entryNames ...string) (int64, error) {
+	if !e.IsFlag() {
+		return 0, ErrMorpheEnumNotKind(e.Name, EnumKindFlag)
+	}
+	var combined int64
+	for _, entryName := range entryNames {
+		entryValue, valueErr := e.IntValue(entryName)
+		if valueErr != nil {
+			return 0, valueErr
+		}
+		combined |= entryValue
+	}
+	return combined, nil
+}
+
+// SplitFlags returns the entries of a flag enum set in the value, in declaration order
+func (e Enum) SplitFlags(value int64) ([]string, error) {
+	if !e.IsFlag() {
+		return nil, ErrMorpheEnumNotKind(e.Name, EnumKindFlag)
+	}
+	entryNames := []string{}
+	remaining := value
+	for _, entryName := range e.GetEntryNames() {
+		entryValue, valueErr := e.IntValue(entryName)
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		if value&entryValue == entryValue {
+			entryNames = append(entryNames, entryName)
+			remaining &^= entryValue
+		}
+	}
+	if remaining != 0 {
+		return nil, ErrMorpheEnumUnknownFlags(e.Name, remaining)
+	}
+	return entryNames, nil
+}
+
+// IsFlagValue returns true if the value is a combination of entry values of a flag enum, including the empty combination
+func (e Enum) IsFlagValue(value any) bool {
+	number, isNumber := toEnumNumber(value)
+	if !isNumber || number != float64(int64(number)) {
+		return false
+	}
+	_, splitErr := e.SplitFlags(int64(number))
+	return splitErr == nil
+}
+
+func (e Enum) validateKind() error {
+	switch e.Kind {
+	case "":
+		return nil
+	case EnumKindFlag:
+		return e.validateFlagEntries()
+	case EnumKindOrdered:
+		return e.validateOrderedEntries()
+	}
+	return ErrMorpheEnumUnsupportedKind(e.Name, e.Kind)
+}
+
+func (e Enum) validateFlagEntries() error {
+	if e.Type != EnumTypeInteger {
+		return ErrMorpheEnumKindTypeMismatch(e.Name, e.Kind, e.Type)
+	}
+	for _, entryName := range e.GetEntryNames() {
+		entryValue, valueErr := e.IntValue(entryName)
+		if valueErr != nil {
+			return valueErr
+		}
+		if entryValue <= 0 || bits.OnesCount64(uint64(entryValue)) != 1 {
+			return ErrMorpheEnumFlagNotPowerOfTwo(e.Name, entryName, entryValue)
+		}
+	}
+	return nil
+}
+
+func (e Enum) validateOrderedEntries() error {
+	for _, entryName := range core.MapKeysSorted(e.Entries) {
+		if !slices.Contains(e.EntryOrder, entryName) {
+			return ErrMorpheEnumUndeclaredOrderedEntry(e.Name, entryName)
+		}
+	}
+	return nil
+}
+
+// validateUniqueEntryValues reports the later entry of the first pair of entries sharing a value, numbers are compared by value
+func (e Enum) validateUniqueEntryValues() error {
+	for _, entryName := range e.GetEntryNames() {
+		entryValue := e.Entries[entryName]
+		firstEntryName, _ := e.KeyFor(entryValue)
+		if firstEntryName != entryName {
+			return ErrMorpheEnumDuplicateEntryValue(e.Name, entryName, firstEntryName, entryValue)
+		}
+	}
+	return nil
+}
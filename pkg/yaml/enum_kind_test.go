@@ -0,0 +1,117 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func getPermissionEnum() Enum {
+	return Enum{
+		Name:       "Permission",
+		Type:       EnumTypeInteger,
+		Kind:       EnumKindFlag,
+		Entries:    map[string]any{"Read": 1, "Write": 2, "Delete": 4},
+		EntryOrder: []string{"Read", "Write", "Delete"},
+	}
+}
+
+func TestEnum_Validate_DuplicateEntryValue(t *testing.T) {
+	enum := Enum{
+		Name:       "Level",
+		Type:       EnumTypeInteger,
+		Entries:    map[string]any{"Low": 1, "Lowest": 1.0, "High": 2},
+		EntryOrder: []string{"Low", "High", "Lowest"},
+	}
+
+	assert.ErrorContains(t, enum.Validate(), "enum 'Level' entry 'Lowest' value '1' duplicates the value of entry 'Low'")
+}
+
+func TestEnum_Validate_UnsupportedTypeAndKind(t *testing.T) {
+	assert.ErrorContains(t, Enum{Name: "Level", Type: "Boolean", Entries: map[string]any{"On": true}}.Validate(), "enum 'Level' type 'Boolean' is not supported")
+	assert.ErrorContains(t, Enum{Name: "Level", Type: EnumTypeInteger, Kind: "Bitmask", Entries: map[string]any{"On": 1}}.Validate(), "enum 'Level' kind 'Bitmask' is not supported")
+}
+
+func TestEnum_Validate_Flag(t *testing.T) {
+	assert.NoError(t, getPermissionEnum().Validate())
+
+	notPowerOfTwo := getPermissionEnum()
+	notPowerOfTwo.Entries["Delete"] = 3
+	assert.ErrorContains(t, notPowerOfTwo.Validate(), "flag enum 'Permission' entry 'Delete' value '3' is not a power of two")
+
+	zero := getPermissionEnum()
+	zero.Entries["Delete"] = 0
+	assert.ErrorContains(t, zero.Validate(), "flag enum 'Permission' entry 'Delete' value '0' is not a power of two")
+
+	stringFlags := Enum{Name: "Permission", Type: EnumTypeString, Kind: EnumKindFlag, Entries: map[string]any{"Read": "read"}}
+	assert.ErrorContains(t, stringFlags.Validate(), "enum 'Permission' of kind 'Flag' must have type 'Integer', not 'String'")
+}
+
+func TestEnum_Flags(t *testing.T) {
+	enum := getPermissionEnum()
+
+	combined, combineErr := enum.CombineFlags("Read", "Delete")
+	require.NoError(t, combineErr)
+	assert.Equal(t, int64(5), combined)
+	_, combineErr = enum.CombineFlags("Execute")
+	assert.ErrorContains(t, combineErr, "enum 'Permission' has no entry 'Execute'")
+
+	entryNames, splitErr := enum.SplitFlags(6)
+	require.NoError(t, splitErr)
+	assert.Equal(t, []string{"Write", "Delete"}, entryNames)
+	_, splitErr = enum.SplitFlags(9)
+	assert.ErrorContains(t, splitErr, "flag enum 'Permission' has no entries for flags '8'")
+
+	assert.True(t, enum.IsFlagValue(7))
+	assert.True(t, enum.IsFlagValue(0))
+	assert.False(t, enum.IsFlagValue(8))
+	assert.False(t, enum.IsFlagValue("1"))
+
+	_, notFlagErr := Enum{Name: "Level"}.CombineFlags()
+	assert.ErrorContains(t, notFlagErr, "enum 'Level' is not of kind 'Flag'")
+}
+
+func TestEnum_Ordered(t *testing.T) {
+	enumYAML := `
+name: Severity
+type: String
+kind: Ordered
+entries:
+  Low: low
+  Medium: medium
+  High: high
+`
+	var enum Enum
+	require.NoError(t, yaml.Unmarshal([]byte(enumYAML), &enum))
+	require.NoError(t, enum.Validate())
+	assert.True(t, enum.IsOrdered())
+
+	comparison, compareErr := enum.CompareEntries("Low", "High")
+	require.NoError(t, compareErr)
+	assert.Equal(t, -1, comparison)
+	comparison, compareErr = enum.CompareEntries("High", "Medium")
+	require.NoError(t, compareErr)
+	assert.Equal(t, 1, comparison)
+	comparison, compareErr = enum.CompareEntries("Medium", "Medium")
+	require.NoError(t, compareErr)
+	assert.Equal(t, 0, comparison)
+	_, compareErr = enum.CompareEntries("Low", "Critical")
+	assert.ErrorContains(t, compareErr, "enum 'Severity' has no entry 'Critical'")
+
+	_, notOrderedErr := getPermissionEnum().CompareEntries("Read", "Write")
+	assert.ErrorContains(t, notOrderedErr, "enum 'Permission' is not of kind 'Ordered'")
+}
+
+func TestEnum_Validate_OrderedUndeclaredEntry(t *testing.T) {
+	enum := Enum{
+		Name:       "Severity",
+		Type:       EnumTypeString,
+		Kind:       EnumKindOrdered,
+		Entries:    map[string]any{"Low": "low", "High": "high"},
+		EntryOrder: []string{"Low"},
+	}
+
+	assert.ErrorContains(t, enum.Validate(), "ordered enum 'Severity' entry 'High' has no declared position")
+}
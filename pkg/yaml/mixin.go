@@ -0,0 +1,73 @@
+package yaml
+
+import (
+	"github.com/kalo-build/clone"
+)
+
+// Mixin is a reusable group of model fields and identifiers that models include by name
+type Mixin struct {
+	Name        string                     `yaml:"name"`
+	Namespace   string                     `yaml:"namespace,omitempty"`
+	Includes    []string                   `yaml:"includes,omitempty"`
+	Fields      map[string]ModelField      `yaml:"fields"`
+	Identifiers map[string]ModelIdentifier `yaml:"identifiers"`
+}
+
+func (m Mixin) Validate() error {
+	if m.Name == "" {
+		return ErrNoMorpheMixinName
+	}
+	if !IsValidDefinitionName(m.Name) {
+		return ErrMorpheDefinitionNameNotCapitalized("mixin", m.Name)
+	}
+	if len(m.Fields) == 0 && len(m.Identifiers) == 0 && len(m.Includes) == 0 {
+		return ErrNoMorpheMixinMembers(m.Name)
+	}
+	return nil
+}
+
+func (m Mixin) DeepClone() Mixin {
+	return Mixin{
+		Name:        m.Name,
+		Namespace:   m.Namespace,
+		Includes:    clone.Slice(m.Includes),
+		Fields:      clone.DeepCloneMap(m.Fields),
+		Identifiers: clone.DeepCloneMap(m.Identifiers),
+	}
+}
+
+// ResolveMixinIncludes returns the names of all transitively included mixins, every mixin follows the mixins it includes
+func ResolveMixinIncludes(ownerName string, includes []string, allMixins map[string]Mixin) ([]string, error) {
+	resolved := []string{}
+	visited := map[string]bool{}
+	var visit func(includerName string, mixinName string, stack []string) error
+	visit = func(includerName string, mixinName string, stack []string) error {
+		for _, stackName := range stack {
+			if stackName == mixinName {
+				return ErrMorpheMixinIncludeCycle(ownerName, append(stack, mixinName))
+			}
+		}
+		if visited[mixinName] {
+			return nil
+		}
+		mixin, mixinExists := allMixins[mixinName]
+		if !mixinExists {
+			return ErrMorpheMixinUnknownInclude(includerName, mixinName)
+		}
+		for _, includedName := range mixin.Includes {
+			if visitErr := visit(mixinName, includedName, append(stack, mixinName)); visitErr != nil {
+				return visitErr
+			}
+		}
+		visited[mixinName] = true
+		resolved = append(resolved, mixinName)
+		return nil
+	}
+
+	for _, mixinName := range includes {
+		if visitErr := visit(ownerName, mixinName, nil); visitErr != nil {
+			return nil, visitErr
+		}
+	}
+	return resolved, nil
+}
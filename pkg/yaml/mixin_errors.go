@@ -0,0 +1,29 @@
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrNoMorpheMixinName = errors.New("morphe mixin has no name")
+
+func ErrNoMorpheMixinMembers(mixinName string) error {
+	return fmt.Errorf("morphe mixin '%s' has no fields, identifiers or includes", mixinName)
+}
+
+func ErrMorpheMixinUnknownInclude(includerName string, mixinName string) error {
+	return fmt.Errorf("morphe definition '%s' includes unknown mixin '%s'", includerName, mixinName)
+}
+
+func ErrMorpheMixinIncludeCycle(ownerName string, cycle []string) error {
+	return fmt.Errorf("morphe definition '%s' includes mixins in a cycle: %s", ownerName, strings.Join(cycle, " -> "))
+}
+
+func ErrMorpheModelMixinFieldConflict(modelName string, fieldName string, mixinName string, conflictSource string) error {
+	return fmt.Errorf("morphe model '%s' field '%s' from mixin '%s' conflicts with %s", modelName, fieldName, mixinName, conflictSource)
+}
+
+func ErrMorpheModelMixinIdentifierConflict(modelName string, identifierName string, mixinName string, conflictSource string) error {
+	return fmt.Errorf("morphe model '%s' identifier '%s' from mixin '%s' conflicts with %s", modelName, identifierName, mixinName, conflictSource)
+}
@@ -0,0 +1,135 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestMixins() map[string]Mixin {
+	return map[string]Mixin{
+		"Keys": {
+			Name: "Keys",
+			Fields: map[string]ModelField{
+				"ID":   {Type: ModelFieldTypeAutoIncrement, Attributes: []string{"mandatory"}},
+				"UUID": {Type: ModelFieldTypeUUID},
+			},
+			Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+		},
+		"Timestamps": {
+			Name: "Timestamps",
+			Fields: map[string]ModelField{
+				"CreatedAt": {Type: ModelFieldTypeTime},
+				"UpdatedAt": {Type: ModelFieldTypeTime},
+			},
+		},
+		"Record": {
+			Name:     "Record",
+			Includes: []string{"Keys", "Timestamps"},
+		},
+	}
+}
+
+func TestMixin_Validate(t *testing.T) {
+	assert.ErrorIs(t, Mixin{}.Validate(), ErrNoMorpheMixinName)
+	assert.ErrorContains(t, Mixin{Name: "Empty"}.Validate(), "morphe mixin 'Empty' has no fields, identifiers or includes")
+	assert.NoError(t, getTestMixins()["Record"].Validate())
+}
+
+func TestMixin_DeepClone(t *testing.T) {
+	original := getTestMixins()["Record"]
+	cloned := original.DeepClone()
+	cloned.Includes[0] = "Other"
+
+	assert.Equal(t, []string{"Keys", "Timestamps"}, original.Includes)
+}
+
+func TestResolveMixinIncludes(t *testing.T) {
+	mixins := getTestMixins()
+
+	mixinNames, resolveErr := ResolveMixinIncludes("Person", []string{"Record", "Keys"}, mixins)
+
+	require.NoError(t, resolveErr)
+	assert.Equal(t, []string{"Keys", "Timestamps", "Record"}, mixinNames)
+}
+
+func TestResolveMixinIncludes_UnknownMixin(t *testing.T) {
+	mixins := getTestMixins()
+	mixins["Record"] = Mixin{Name: "Record", Includes: []string{"Audit"}}
+
+	_, resolveErr := ResolveMixinIncludes("Person", []string{"Record"}, mixins)
+
+	assert.ErrorContains(t, resolveErr, "morphe definition 'Record' includes unknown mixin 'Audit'")
+}
+
+func TestResolveMixinIncludes_Cycle(t *testing.T) {
+	mixins := getTestMixins()
+	mixins["Keys"] = Mixin{Name: "Keys", Includes: []string{"Record"}}
+
+	_, resolveErr := ResolveMixinIncludes("Person", []string{"Record"}, mixins)
+
+	assert.ErrorContains(t, resolveErr, "morphe definition 'Person' includes mixins in a cycle: Record -> Keys -> Record")
+}
+
+func TestModel_ApplyMixins(t *testing.T) {
+	model := Model{
+		Name:        "Person",
+		Includes:    []string{"Record"},
+		Fields:      map[string]ModelField{"Name": {Type: ModelFieldTypeString}},
+		Identifiers: map[string]ModelIdentifier{"name": {Fields: []string{"Name"}}},
+	}
+
+	flattened, applyErr := model.ApplyMixins(getTestMixins())
+
+	require.NoError(t, applyErr)
+	assert.Nil(t, flattened.Includes)
+	assert.Equal(t, []string{"CreatedAt", "ID", "Name", "UUID", "UpdatedAt"}, core.MapKeysSorted(flattened.Fields))
+	assert.Equal(t, []string{"name", "primary"}, core.MapKeysSorted(flattened.Identifiers))
+	assert.Equal(t, []string{"Record"}, model.Includes)
+	assert.Len(t, model.Fields, 1)
+}
+
+func TestModel_ApplyMixins_NoIncludes(t *testing.T) {
+	model := Model{Name: "Person", Fields: map[string]ModelField{"Name": {Type: ModelFieldTypeString}}}
+
+	flattened, applyErr := model.ApplyMixins(nil)
+
+	require.NoError(t, applyErr)
+	assert.Equal(t, model, flattened)
+}
+
+func TestModel_ApplyMixins_ModelFieldConflict(t *testing.T) {
+	model := Model{
+		Name:     "Person",
+		Includes: []string{"Keys"},
+		Fields:   map[string]ModelField{"ID": {Type: ModelFieldTypeInteger}},
+	}
+
+	_, applyErr := model.ApplyMixins(getTestMixins())
+
+	assert.ErrorContains(t, applyErr, "morphe model 'Person' field 'ID' from mixin 'Keys' conflicts with the model field")
+}
+
+func TestModel_ApplyMixins_MixinFieldConflict(t *testing.T) {
+	mixins := getTestMixins()
+	mixins["Audit"] = Mixin{Name: "Audit", Fields: map[string]ModelField{"CreatedAt": {Type: ModelFieldTypeDate}}}
+	model := Model{Name: "Person", Includes: []string{"Timestamps", "Audit"}}
+
+	_, applyErr := model.ApplyMixins(mixins)
+
+	assert.ErrorContains(t, applyErr, "morphe model 'Person' field 'CreatedAt' from mixin 'Audit' conflicts with mixin 'Timestamps'")
+}
+
+func TestModel_ApplyMixins_IdentifierConflict(t *testing.T) {
+	model := Model{
+		Name:        "Person",
+		Includes:    []string{"Keys"},
+		Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"Name"}}},
+	}
+
+	_, applyErr := model.ApplyMixins(getTestMixins())
+
+	assert.ErrorContains(t, applyErr, "morphe model 'Person' identifier 'primary' from mixin 'Keys' conflicts with the model identifier")
+}
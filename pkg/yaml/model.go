@@ -6,25 +6,36 @@ import (
 	"strings"
 
 	"github.com/kalo-build/clone"
+	"github.com/kalo-build/go-util/core"
 )
 
 type Model struct {
 	Name        string                     `yaml:"name"`
+	Namespace   string                     `yaml:"namespace,omitempty"`
+	Includes    []string                   `yaml:"includes,omitempty"`
 	Fields      map[string]ModelField      `yaml:"fields"`
 	Identifiers map[string]ModelIdentifier `yaml:"identifiers"`
 	Related     map[string]ModelRelation   `yaml:"related"`
+	Deprecated  *Deprecation               `yaml:"deprecated,omitempty"`
 }
 
 func (m Model) Validate(allEnums map[string]Enum) error {
 	if m.Name == "" {
 		return ErrNoMorpheModelName
 	}
+	if !IsValidDefinitionName(m.Name) {
+		return ErrMorpheDefinitionNameNotCapitalized("model", m.Name)
+	}
 	if len(m.Fields) == 0 {
 		return ErrNoMorpheModelFields
 	}
 	if len(m.Identifiers) == 0 {
 		return ErrNoMorpheModelIdentifiers
 	}
+	renamedFromErr := m.validateFieldsRenamedFrom()
+	if renamedFromErr != nil {
+		return renamedFromErr
+	}
 	if len(allEnums) == 0 {
 		return nil
 	}
@@ -49,6 +60,11 @@ func (m Model) ValidateWithModels(allModels map[string]Model, allEnums map[strin
 		return err
 	}
 
+	// Validate relation options against the related models
+	if err := m.validateRelationOptions(allModels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -109,6 +125,101 @@ func (m Model) validatePolymorphicInverseAliasing(relationName string, relation
 	return nil
 }
 
+func (m Model) validateRelationOptions(allModels map[string]Model) error {
+	for _, relationName := range core.MapKeysSorted(m.Related) {
+		relation := m.Related[relationName]
+		if err := m.validateRelationOnDelete(relationName, relation); err != nil {
+			return err
+		}
+		if err := m.validateRelationForeignKey(relationName, relation, allModels); err != nil {
+			return err
+		}
+		if err := m.validateRelationOrderBy(relationName, relation, allModels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Model) validateRelationOnDelete(relationName string, relation ModelRelation) error {
+	if relation.OnDelete == "" {
+		return nil
+	}
+	if !IsModelRelationOnDeleteValid(relation.OnDelete) {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "onDelete",
+			fmt.Sprintf("unknown action '%s'", relation.OnDelete))
+	}
+	if relation.Required && relation.OnDelete == ModelRelationOnDeleteSetNull {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "onDelete",
+			fmt.Sprintf("action '%s' cannot be used on a required relation", relation.OnDelete))
+	}
+	return nil
+}
+
+func (m Model) validateRelationForeignKey(relationName string, relation ModelRelation, allModels map[string]Model) error {
+	if relation.ForeignKey == "" {
+		return nil
+	}
+
+	// 'For' relations hold the foreign key themselves, 'Has' relations expect it on the related model
+	ownerModel := m
+	if m.isRelationHas(relation.Type) {
+		targetModel, targetErr := m.resolveRelationTarget(relationName, relation, allModels)
+		if targetErr != nil {
+			return targetErr
+		}
+		ownerModel = targetModel
+	}
+
+	if _, exists := ownerModel.Fields[relation.ForeignKey]; !exists {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "foreignKey",
+			fmt.Sprintf("field '%s' does not exist in model '%s'", relation.ForeignKey, ownerModel.Name))
+	}
+	return nil
+}
+
+func (m Model) validateRelationOrderBy(relationName string, relation ModelRelation, allModels map[string]Model) error {
+	if relation.OrderBy.IsZero() {
+		return nil
+	}
+	if !m.isRelationHas(relation.Type) || !m.isRelationMany(relation.Type) {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "orderBy",
+			fmt.Sprintf("ordering is only supported on HasMany and HasManyPoly relations (type: %s)", relation.Type))
+	}
+	if relation.OrderBy.Field == "" {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "orderBy", "no field specified")
+	}
+
+	direction := relation.OrderBy.Direction
+	if direction != "" && direction != ModelRelationOrderDirectionAsc && direction != ModelRelationOrderDirectionDesc {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "orderBy",
+			fmt.Sprintf("unknown direction '%s'", direction))
+	}
+
+	targetModel, targetErr := m.resolveRelationTarget(relationName, relation, allModels)
+	if targetErr != nil {
+		return targetErr
+	}
+	if _, exists := targetModel.Fields[relation.OrderBy.Field]; !exists {
+		return ErrMorpheModelInvalidRelationOption(m.Name, relationName, "orderBy",
+			fmt.Sprintf("field '%s' does not exist in model '%s'", relation.OrderBy.Field, targetModel.Name))
+	}
+	return nil
+}
+
+func (m Model) resolveRelationTarget(relationName string, relation ModelRelation, allModels map[string]Model) (Model, error) {
+	targetName := relationName
+	if strings.TrimSpace(relation.Aliased) != "" {
+		targetName = strings.TrimSpace(relation.Aliased)
+	}
+
+	targetModel, exists := allModels[targetName]
+	if !exists {
+		return Model{}, ErrMorpheModelUnknownRelationTarget(m.Name, relationName, targetName)
+	}
+	return targetModel, nil
+}
+
 // Helper functions copied from yamlops to avoid import cycle
 func (m Model) isRelationFor(relationType string) bool {
 	return strings.HasPrefix(strings.ToLower(relationType), "for")
@@ -118,6 +229,10 @@ func (m Model) isRelationHas(relationType string) bool {
 	return strings.HasPrefix(strings.ToLower(relationType), "has")
 }
 
+func (m Model) isRelationMany(relationType string) bool {
+	return strings.Contains(strings.ToLower(relationType), "many")
+}
+
 func (m Model) isRelationPoly(relationType string) bool {
 	lowerType := strings.ToLower(relationType)
 	return (m.isRelationFor(relationType) || m.isRelationHas(relationType)) &&
@@ -135,9 +250,12 @@ func (m Model) isRelationPolyHas(relationType string) bool {
 func (m Model) DeepClone() Model {
 	modelCopy := Model{
 		Name:        m.Name,
+		Namespace:   m.Namespace,
+		Includes:    clone.Slice(m.Includes),
 		Fields:      clone.DeepCloneMap(m.Fields),
 		Identifiers: clone.DeepCloneMap(m.Identifiers),
 		Related:     clone.DeepCloneMap(m.Related),
+		Deprecated:  m.Deprecated.DeepClone(),
 	}
 
 	return modelCopy
@@ -158,6 +276,24 @@ func (m Model) GetIdentifierFields() []ModelField {
 	return fields
 }
 
+func (m Model) validateFieldsRenamedFrom() error {
+	renamedFromFields := map[string]string{}
+	for _, fieldName := range core.MapKeysSorted(m.Fields) {
+		renamedFrom := m.Fields[fieldName].RenamedFrom
+		if renamedFrom == "" {
+			continue
+		}
+		if _, exists := m.Fields[renamedFrom]; exists {
+			return ErrMorpheModelFieldRenamedFromExisting(m.Name, fieldName, renamedFrom)
+		}
+		if otherFieldName, conflict := renamedFromFields[renamedFrom]; conflict {
+			return ErrMorpheModelFieldRenamedFromConflict(m.Name, fieldName, otherFieldName, renamedFrom)
+		}
+		renamedFromFields[renamedFrom] = fieldName
+	}
+	return nil
+}
+
 func (m Model) validateFieldTypes(allEnums map[string]Enum) error {
 	if len(allEnums) == 0 {
 		return nil
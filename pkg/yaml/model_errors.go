@@ -13,6 +13,14 @@ func ErrMorpheModelUnknownFieldType(fieldName string, typeName string) error {
 	return fmt.Errorf("morphe model field '%s' has unknown non-primitive type '%s'", fieldName, typeName)
 }
 
+func ErrMorpheModelFieldRenamedFromExisting(modelName string, fieldName string, renamedFrom string) error {
+	return fmt.Errorf("morphe model '%s' field '%s' is renamed from '%s', which is still a field of the model", modelName, fieldName, renamedFrom)
+}
+
+func ErrMorpheModelFieldRenamedFromConflict(modelName string, fieldName string, otherFieldName string, renamedFrom string) error {
+	return fmt.Errorf("morphe model '%s' fields '%s' and '%s' are both renamed from '%s'", modelName, fieldName, otherFieldName, renamedFrom)
+}
+
 func ErrMorpheModelUnknownAliasedTarget(modelName string, relationName string, aliasedTarget string) error {
 	return fmt.Errorf("morphe model '%s' relation '%s' has unknown aliased target: %s", modelName, relationName, aliasedTarget)
 }
@@ -20,3 +28,11 @@ func ErrMorpheModelUnknownAliasedTarget(modelName string, relationName string, a
 func ErrMorpheModelPolymorphicInverseValidation(modelName string, relationName string, aliasedTarget string, through string, reason string) error {
 	return fmt.Errorf("morphe model '%s' polymorphic inverse relation '%s' (aliased: %s, through: %s): %s", modelName, relationName, aliasedTarget, through, reason)
 }
+
+func ErrMorpheModelUnknownRelationTarget(modelName string, relationName string, targetName string) error {
+	return fmt.Errorf("morphe model '%s' relation '%s' has unknown target model: %s", modelName, relationName, targetName)
+}
+
+func ErrMorpheModelInvalidRelationOption(modelName string, relationName string, option string, reason string) error {
+	return fmt.Errorf("morphe model '%s' relation '%s' has invalid option '%s': %s", modelName, relationName, option, reason)
+}
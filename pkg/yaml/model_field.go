@@ -5,11 +5,18 @@ import "github.com/kalo-build/clone"
 type ModelField struct {
 	Type       ModelFieldType `yaml:"type"`
 	Attributes []string       `yaml:"attributes"`
+
+	// RenamedFrom is the previous name of the field, used to plan data-preserving migrations
+	RenamedFrom string `yaml:"renamedFrom,omitempty"`
+
+	Deprecated *Deprecation `yaml:"deprecated,omitempty"`
 }
 
 func (f ModelField) DeepClone() ModelField {
 	return ModelField{
-		Type:       f.Type,
-		Attributes: clone.Slice(f.Attributes),
+		Type:        f.Type,
+		Attributes:  clone.Slice(f.Attributes),
+		RenamedFrom: f.RenamedFrom,
+		Deprecated:  f.Deprecated.DeepClone(),
 	}
 }
@@ -0,0 +1,70 @@
+package yaml
+
+// RelationCardinality describes how many related records a single relation hop yields
+type RelationCardinality string
+
+const (
+	RelationCardinalityOne  RelationCardinality = "One"
+	RelationCardinalityMany RelationCardinality = "Many"
+)
+
+// ModelFieldPathTypeKind describes which kind of type the terminal field of a resolved path has
+type ModelFieldPathTypeKind string
+
+const (
+	ModelFieldPathTypeKindPrimitive ModelFieldPathTypeKind = "Primitive"
+	ModelFieldPathTypeKindEnum      ModelFieldPathTypeKind = "Enum"
+	ModelFieldPathTypeKindStructure ModelFieldPathTypeKind = "Structure"
+)
+
+// ModelFieldPathHop is a single model relation walked while resolving a model field path
+type ModelFieldPathHop struct {
+	RelationName string
+	Relation     ModelRelation
+	FromModel    string
+	ToModel      string
+	Cardinality  RelationCardinality
+}
+
+// ResolvedModelFieldPath is the full traversal of a model field path, from its root model to its terminal field
+type ResolvedModelFieldPath struct {
+	Path ModelFieldPath
+
+	// Models holds the root model followed by the model reached after each hop
+	Models []Model
+	Hops   []ModelFieldPathHop
+
+	TerminalFieldName string
+	TerminalField     ModelField
+
+	TypeKind  ModelFieldPathTypeKind
+	Primitive ModelFieldType
+	Enum      *Enum
+	Structure *Structure
+}
+
+// RootModel returns the model the path starts at
+func (p ResolvedModelFieldPath) RootModel() Model {
+	if len(p.Models) == 0 {
+		return Model{}
+	}
+	return p.Models[0]
+}
+
+// TerminalModel returns the model that owns the terminal field
+func (p ResolvedModelFieldPath) TerminalModel() Model {
+	if len(p.Models) == 0 {
+		return Model{}
+	}
+	return p.Models[len(p.Models)-1]
+}
+
+// IsToMany returns true if any hop of the path yields many related records
+func (p ResolvedModelFieldPath) IsToMany() bool {
+	for _, hop := range p.Hops {
+		if hop.Cardinality == RelationCardinalityMany {
+			return true
+		}
+	}
+	return false
+}
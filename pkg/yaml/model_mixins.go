@@ -0,0 +1,56 @@
+package yaml
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/go-util/core"
+)
+
+// ApplyMixins returns the flattened model with the fields and identifiers of all included mixins merged in.
+// Members defined more than once across the model and its mixins are conflicts.
+func (m Model) ApplyMixins(allMixins map[string]Mixin) (Model, error) {
+	if len(m.Includes) == 0 {
+		return m, nil
+	}
+	mixinNames, resolveErr := ResolveMixinIncludes(m.Name, m.Includes, allMixins)
+	if resolveErr != nil {
+		return Model{}, resolveErr
+	}
+
+	flattened := m.DeepClone()
+	flattened.Includes = nil
+	if flattened.Fields == nil {
+		flattened.Fields = map[string]ModelField{}
+	}
+	if flattened.Identifiers == nil {
+		flattened.Identifiers = map[string]ModelIdentifier{}
+	}
+
+	fieldSources := map[string]string{}
+	for fieldName := range m.Fields {
+		fieldSources[fieldName] = "the model field"
+	}
+	identifierSources := map[string]string{}
+	for identifierName := range m.Identifiers {
+		identifierSources[identifierName] = "the model identifier"
+	}
+
+	for _, mixinName := range mixinNames {
+		mixin := allMixins[mixinName]
+		for _, fieldName := range core.MapKeysSorted(mixin.Fields) {
+			if conflictSource, exists := fieldSources[fieldName]; exists {
+				return Model{}, ErrMorpheModelMixinFieldConflict(m.Name, fieldName, mixinName, conflictSource)
+			}
+			fieldSources[fieldName] = fmt.Sprintf("mixin '%s'", mixinName)
+			flattened.Fields[fieldName] = mixin.Fields[fieldName].DeepClone()
+		}
+		for _, identifierName := range core.MapKeysSorted(mixin.Identifiers) {
+			if conflictSource, exists := identifierSources[identifierName]; exists {
+				return Model{}, ErrMorpheModelMixinIdentifierConflict(m.Name, identifierName, mixinName, conflictSource)
+			}
+			identifierSources[identifierName] = fmt.Sprintf("mixin '%s'", mixinName)
+			flattened.Identifiers[identifierName] = mixin.Identifiers[identifierName].DeepClone()
+		}
+	}
+	return flattened, nil
+}
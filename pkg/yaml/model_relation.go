@@ -3,17 +3,27 @@ package yaml
 import "github.com/kalo-build/clone"
 
 type ModelRelation struct {
-	Type    string   `yaml:"type"`
-	For     []string `yaml:"for,omitempty"`
-	Through string   `yaml:"through,omitempty"`
-	Aliased string   `yaml:"aliased,omitempty"`
+	Type       string                `yaml:"type"`
+	For        []string              `yaml:"for,omitempty"`
+	Through    string                `yaml:"through,omitempty"`
+	Aliased    string                `yaml:"aliased,omitempty"`
+	Required   bool                  `yaml:"required,omitempty"`
+	OnDelete   ModelRelationOnDelete `yaml:"onDelete,omitempty"`
+	ForeignKey string                `yaml:"foreignKey,omitempty"`
+	OrderBy    ModelRelationOrderBy  `yaml:"orderBy,omitempty"`
+	Deprecated *Deprecation          `yaml:"deprecated,omitempty"`
 }
 
 func (r ModelRelation) DeepClone() ModelRelation {
 	return ModelRelation{
-		Type:    r.Type,
-		For:     clone.Slice(r.For),
-		Through: r.Through,
-		Aliased: r.Aliased,
+		Type:       r.Type,
+		For:        clone.Slice(r.For),
+		Through:    r.Through,
+		Aliased:    r.Aliased,
+		Required:   r.Required,
+		OnDelete:   r.OnDelete,
+		ForeignKey: r.ForeignKey,
+		OrderBy:    r.OrderBy,
+		Deprecated: r.Deprecated.DeepClone(),
 	}
 }
@@ -0,0 +1,22 @@
+package yaml
+
+import "slices"
+
+// ModelRelationOnDelete is the referential action applied to a relation when its referenced record is deleted
+type ModelRelationOnDelete string
+
+const (
+	ModelRelationOnDeleteCascade  ModelRelationOnDelete = "cascade"
+	ModelRelationOnDeleteRestrict ModelRelationOnDelete = "restrict"
+	ModelRelationOnDeleteSetNull  ModelRelationOnDelete = "setNull"
+)
+
+var ModelRelationOnDeleteActions = []ModelRelationOnDelete{
+	ModelRelationOnDeleteCascade,
+	ModelRelationOnDeleteRestrict,
+	ModelRelationOnDeleteSetNull,
+}
+
+func IsModelRelationOnDeleteValid(action ModelRelationOnDelete) bool {
+	return slices.Contains(ModelRelationOnDeleteActions, action)
+}
@@ -0,0 +1,43 @@
+package yaml
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+type ModelRelationOrderDirection string
+
+const (
+	ModelRelationOrderDirectionAsc  ModelRelationOrderDirection = "asc"
+	ModelRelationOrderDirectionDesc ModelRelationOrderDirection = "desc"
+)
+
+// ModelRelationOrderBy is the default ordering applied to the records of a to-many relation
+type ModelRelationOrderBy struct {
+	Field     string                      `yaml:"field"`
+	Direction ModelRelationOrderDirection `yaml:"direction,omitempty"`
+}
+
+// IsZero reports whether no ordering was declared
+func (o ModelRelationOrderBy) IsZero() bool {
+	return o.Field == "" && o.Direction == ""
+}
+
+func (o *ModelRelationOrderBy) UnmarshalYAML(value *yaml.Node) error {
+	var fieldName string
+	unmarshalErr := value.Decode(&fieldName)
+	if unmarshalErr == nil {
+		o.Field = fieldName
+		o.Direction = ""
+		return nil
+	}
+
+	type orderByFields ModelRelationOrderBy
+	var orderBy orderByFields
+	unmarshalErr = value.Decode(&orderBy)
+	if unmarshalErr == nil {
+		*o = ModelRelationOrderBy(orderBy)
+		return nil
+	}
+
+	return unmarshalErr
+}
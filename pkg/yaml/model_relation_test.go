@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestModelRelationDeepClone(t *testing.T) {
@@ -86,3 +88,57 @@ func TestModelRelationAliased_PolymorphicWithAlias(t *testing.T) {
 	assert.Equal(t, "Commentable", aliasedPolyRelation.Aliased)
 	assert.Empty(t, aliasedPolyRelation.Through)
 }
+
+func TestModelRelationDeepCloneWithOptions(t *testing.T) {
+	original := ModelRelation{
+		Type:       "HasMany",
+		Required:   true,
+		OnDelete:   ModelRelationOnDeleteCascade,
+		ForeignKey: "OwnerID",
+		OrderBy: ModelRelationOrderBy{
+			Field:     "CreatedAt",
+			Direction: ModelRelationOrderDirectionDesc,
+		},
+	}
+
+	cloned := original.DeepClone()
+
+	assert.Equal(t, original, cloned)
+
+	cloned.OrderBy.Field = "Modified"
+	assert.Equal(t, "CreatedAt", original.OrderBy.Field)
+}
+
+func TestModelRelationUnmarshalOptions(t *testing.T) {
+	relationYAML := `
+type: HasMany
+required: true
+onDelete: cascade
+foreignKey: OwnerID
+orderBy: CreatedAt
+`
+	var relation ModelRelation
+	require.NoError(t, yaml.Unmarshal([]byte(relationYAML), &relation))
+
+	assert.True(t, relation.Required)
+	assert.Equal(t, ModelRelationOnDeleteCascade, relation.OnDelete)
+	assert.Equal(t, "OwnerID", relation.ForeignKey)
+	assert.Equal(t, "CreatedAt", relation.OrderBy.Field)
+	assert.Empty(t, relation.OrderBy.Direction)
+}
+
+func TestModelRelationUnmarshalOptions_OrderByWithDirection(t *testing.T) {
+	relationYAML := `
+type: HasMany
+orderBy:
+  field: CreatedAt
+  direction: desc
+`
+	var relation ModelRelation
+	require.NoError(t, yaml.Unmarshal([]byte(relationYAML), &relation))
+
+	assert.Equal(t, "CreatedAt", relation.OrderBy.Field)
+	assert.Equal(t, ModelRelationOrderDirectionDesc, relation.OrderBy.Direction)
+	assert.False(t, relation.Required)
+	assert.Empty(t, relation.OnDelete)
+}
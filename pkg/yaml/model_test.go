@@ -445,3 +445,200 @@ func TestModelValidateWithModels_AliasedRelation_WhitespaceHandling(t *testing.T
 	err := personModel.ValidateWithModels(allModels, allEnums)
 	assert.NoError(t, err)
 }
+
+func relationOptionsTestModels(companyRelation ModelRelation, personRelation ModelRelation) (Model, Model, map[string]Model) {
+	companyModel := Model{
+		Name: "Company",
+		Fields: map[string]ModelField{
+			"ID":   {Type: "AutoIncrement"},
+			"Name": {Type: "String"},
+		},
+		Identifiers: map[string]ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+		Related: map[string]ModelRelation{
+			"Person": companyRelation,
+		},
+	}
+
+	personModel := Model{
+		Name: "Person",
+		Fields: map[string]ModelField{
+			"ID":         {Type: "AutoIncrement"},
+			"EmployerID": {Type: "Integer"},
+			"LastName":   {Type: "String"},
+		},
+		Identifiers: map[string]ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+		Related: map[string]ModelRelation{
+			"Company": personRelation,
+		},
+	}
+
+	allModels := map[string]Model{
+		"Company": companyModel,
+		"Person":  personModel,
+	}
+	return companyModel, personModel, allModels
+}
+
+func TestModelValidateWithModels_RelationOptions_Success(t *testing.T) {
+	companyModel, personModel, allModels := relationOptionsTestModels(
+		ModelRelation{
+			Type:       "HasMany",
+			ForeignKey: "EmployerID",
+			OrderBy:    ModelRelationOrderBy{Field: "LastName", Direction: ModelRelationOrderDirectionAsc},
+		},
+		ModelRelation{
+			Type:       "ForOne",
+			Required:   true,
+			OnDelete:   ModelRelationOnDeleteCascade,
+			ForeignKey: "EmployerID",
+		},
+	)
+
+	assert.NoError(t, companyModel.ValidateWithModels(allModels, map[string]Enum{}))
+	assert.NoError(t, personModel.ValidateWithModels(allModels, map[string]Enum{}))
+}
+
+func TestModelValidateWithModels_RelationOptions_InvalidOnDelete(t *testing.T) {
+	_, personModel, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany"},
+		ModelRelation{Type: "ForOne", OnDelete: "explode"},
+	)
+
+	err := personModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid option 'onDelete'")
+	assert.Contains(t, err.Error(), "unknown action 'explode'")
+}
+
+func TestModelValidateWithModels_RelationOptions_RequiredWithSetNull(t *testing.T) {
+	_, personModel, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany"},
+		ModelRelation{Type: "ForOne", Required: true, OnDelete: ModelRelationOnDeleteSetNull},
+	)
+
+	err := personModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be used on a required relation")
+}
+
+func TestModelValidateWithModels_RelationOptions_UnknownForeignKey(t *testing.T) {
+	companyModel, personModel, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany", ForeignKey: "CompanyID"},
+		ModelRelation{Type: "ForOne", ForeignKey: "CompanyID"},
+	)
+
+	// 'Has' relations look up the foreign key on the related model
+	companyErr := companyModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, companyErr)
+	assert.Contains(t, companyErr.Error(), "field 'CompanyID' does not exist in model 'Person'")
+
+	// 'For' relations look up the foreign key on the model itself
+	personErr := personModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, personErr)
+	assert.Contains(t, personErr.Error(), "field 'CompanyID' does not exist in model 'Person'")
+}
+
+func TestModelValidateWithModels_RelationOptions_OrderByOnNonHasMany(t *testing.T) {
+	_, personModel, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany"},
+		ModelRelation{Type: "ForOne", OrderBy: ModelRelationOrderBy{Field: "Name"}},
+	)
+
+	err := personModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only supported on HasMany and HasManyPoly relations")
+}
+
+func TestModelValidateWithModels_RelationOptions_OrderByUnknownField(t *testing.T) {
+	companyModel, _, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany", OrderBy: ModelRelationOrderBy{Field: "Age"}},
+		ModelRelation{Type: "ForOne"},
+	)
+
+	err := companyModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "field 'Age' does not exist in model 'Person'")
+}
+
+func TestModelValidateWithModels_RelationOptions_OrderByInvalidDirection(t *testing.T) {
+	companyModel, _, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany", OrderBy: ModelRelationOrderBy{Field: "LastName", Direction: "sideways"}},
+		ModelRelation{Type: "ForOne"},
+	)
+
+	err := companyModel.ValidateWithModels(allModels, map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown direction 'sideways'")
+}
+
+func TestModelValidateWithModels_RelationOptions_AliasedTarget(t *testing.T) {
+	companyModel, _, allModels := relationOptionsTestModels(
+		ModelRelation{Type: "HasMany"},
+		ModelRelation{Type: "ForOne"},
+	)
+	companyModel.Related = map[string]ModelRelation{
+		"Employees": {
+			Type:    "HasMany",
+			Aliased: "Person",
+			OrderBy: ModelRelationOrderBy{Field: "LastName"},
+		},
+	}
+	allModels["Company"] = companyModel
+
+	assert.NoError(t, companyModel.ValidateWithModels(allModels, map[string]Enum{}))
+}
+
+func TestModelValidate_FieldRenamedFrom(t *testing.T) {
+	personModel := Model{
+		Name: "Person",
+		Fields: map[string]ModelField{
+			"ID":          {Type: "AutoIncrement"},
+			"DisplayName": {Type: "String", RenamedFrom: "Nickname"},
+		},
+		Identifiers: map[string]ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+
+	assert.NoError(t, personModel.Validate(map[string]Enum{}))
+}
+
+func TestModelValidate_FieldRenamedFromExistingField(t *testing.T) {
+	personModel := Model{
+		Name: "Person",
+		Fields: map[string]ModelField{
+			"ID":          {Type: "AutoIncrement"},
+			"Nickname":    {Type: "String"},
+			"DisplayName": {Type: "String", RenamedFrom: "Nickname"},
+		},
+		Identifiers: map[string]ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+
+	err := personModel.Validate(map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "field 'DisplayName' is renamed from 'Nickname', which is still a field of the model")
+}
+
+func TestModelValidate_FieldRenamedFromConflict(t *testing.T) {
+	personModel := Model{
+		Name: "Person",
+		Fields: map[string]ModelField{
+			"ID":          {Type: "AutoIncrement"},
+			"Alias":       {Type: "String", RenamedFrom: "Nickname"},
+			"DisplayName": {Type: "String", RenamedFrom: "Nickname"},
+		},
+		Identifiers: map[string]ModelIdentifier{
+			"primary": {Fields: []string{"ID"}},
+		},
+	}
+
+	err := personModel.Validate(map[string]Enum{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "fields 'DisplayName' and 'Alias' are both renamed from 'Nickname'")
+}
@@ -0,0 +1,84 @@
+package yaml
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NamespaceSeparator joins a namespace and a definition name into a qualified name, such as 'billing.Invoice'
+const NamespaceSeparator = "."
+
+// namespaceRegexp matches namespaces made of lowercase segments, which keeps them apart from capitalized definition names in paths
+var namespaceRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+
+// IsValidNamespace returns true if the namespace consists of dot separated lowercase segments
+func IsValidNamespace(namespace string) bool {
+	return namespaceRegexp.MatchString(namespace)
+}
+
+// IsValidDefinitionName returns true if the local definition name starts with a capital letter, lowercase segments of paths are read as namespaces
+func IsValidDefinitionName(name string) bool {
+	_, localName := SplitQualifiedName(name)
+	firstRune, _ := utf8.DecodeRuneInString(localName)
+	return unicode.IsUpper(firstRune)
+}
+
+// QualifyName prefixes a definition name with its namespace, names that are already qualified or without namespace are returned as is
+func QualifyName(namespace string, name string) string {
+	if namespace == "" || IsQualifiedName(name) {
+		return name
+	}
+	return namespace + NamespaceSeparator + name
+}
+
+// IsQualifiedName returns true if the definition name is prefixed by a namespace
+func IsQualifiedName(name string) bool {
+	return strings.Contains(name, NamespaceSeparator)
+}
+
+// SplitQualifiedName returns the namespace and the local name of a definition name
+func SplitQualifiedName(name string) (string, string) {
+	separatorIdx := strings.LastIndex(name, NamespaceSeparator)
+	if separatorIdx == -1 {
+		return "", name
+	}
+	return name[:separatorIdx], name[separatorIdx+1:]
+}
+
+// ResolveNamespacedName returns the qualified name of a definition of the namespace if it exists, unqualified names fall back to the global definition
+func ResolveNamespacedName(namespace string, name string, exists func(string) bool) string {
+	if namespace == "" || name == "" || IsQualifiedName(name) {
+		return name
+	}
+	qualifiedName := QualifyName(namespace, name)
+	if exists(qualifiedName) {
+		return qualifiedName
+	}
+	return name
+}
+
+// SplitModelFieldPath splits a model field path into its segments, keeping the namespace of the root model in the first segment.
+// Leading lowercase segments are read as the namespace, which is why definition names must be capitalized, see IsValidDefinitionName
+func SplitModelFieldPath(path ModelFieldPath) []string {
+	segments := strings.Split(string(path), ".")
+	rootEnd := getQualifiedRootEnd(segments)
+	return append([]string{strings.Join(segments[:rootEnd+1], ".")}, segments[rootEnd+1:]...)
+}
+
+// GetAliasedTargetName returns the definition name of an aliased target, dropping the inverse relation of polymorphic targets such as 'Comment.Commentable'
+func GetAliasedTargetName(aliased string) string {
+	segments := strings.Split(strings.TrimSpace(aliased), ".")
+	return strings.Join(segments[:getQualifiedRootEnd(segments)+1], ".")
+}
+
+// getQualifiedRootEnd returns the index of the first segment that is not part of a namespace
+func getQualifiedRootEnd(segments []string) int {
+	for i, segment := range segments {
+		if !IsValidNamespace(segment) {
+			return i
+		}
+	}
+	return 0
+}
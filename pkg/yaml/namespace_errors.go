@@ -0,0 +1,7 @@
+package yaml
+
+import "fmt"
+
+func ErrMorpheDefinitionNameNotCapitalized(kind string, name string) error {
+	return fmt.Errorf("morphe %s name '%s' must start with a capital letter", kind, name)
+}
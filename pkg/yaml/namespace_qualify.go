@@ -0,0 +1,193 @@
+package yaml
+
+import (
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+)
+
+// QualifyNamespace returns the enum with its name qualified by its namespace
+func (e Enum) QualifyNamespace() Enum {
+	if e.Namespace == "" {
+		return e
+	}
+	qualified := e.DeepClone()
+	qualified.Name = QualifyName(e.Namespace, e.Name)
+	return qualified
+}
+
+// QualifyNamespace returns the structure with its name and all field types that name a definition of its namespace qualified
+func (s Structure) QualifyNamespace(isType func(string) bool) Structure {
+	if s.Namespace == "" {
+		return s
+	}
+	qualified := s.DeepClone()
+	qualified.Name = QualifyName(s.Namespace, s.Name)
+	for fieldName, field := range qualified.Fields {
+		field.Type = StructureFieldType(ResolveNamespacedName(s.Namespace, string(field.Type), isType))
+		qualified.Fields[fieldName] = field
+	}
+	return qualified
+}
+
+// QualifyNamespace returns the mixin with its name, included mixins and field types that name a definition of its namespace qualified
+func (m Mixin) QualifyNamespace(isType func(string) bool, isMixin func(string) bool) Mixin {
+	if m.Namespace == "" {
+		return m
+	}
+	qualified := m.DeepClone()
+	qualified.Name = QualifyName(m.Namespace, m.Name)
+	qualified.Includes = qualifyNames(m.Namespace, qualified.Includes, isMixin)
+	for fieldName, field := range qualified.Fields {
+		field.Type = ModelFieldType(ResolveNamespacedName(m.Namespace, string(field.Type), isType))
+		qualified.Fields[fieldName] = field
+	}
+	return qualified
+}
+
+// QualifyIncludes returns the model with the included mixins that name a mixin of its namespace qualified
+func (m Model) QualifyIncludes(isMixin func(string) bool) Model {
+	if m.Namespace == "" || len(m.Includes) == 0 {
+		return m
+	}
+	qualified := m.DeepClone()
+	qualified.Includes = qualifyNames(m.Namespace, qualified.Includes, isMixin)
+	return qualified
+}
+
+// QualifyNamespace returns the model with its name, field types and relation targets that name a definition of its namespace qualified.
+// Qualified relation names such as 'billing.Invoice' become local relation names aliased to the qualified target.
+func (m Model) QualifyNamespace(isType func(string) bool, isModel func(string) bool) Model {
+	if m.Namespace == "" && !hasQualifiedKeys(m.Related) {
+		return m
+	}
+	qualified := m.DeepClone()
+	qualified.Name = QualifyName(m.Namespace, m.Name)
+	for fieldName, field := range qualified.Fields {
+		field.Type = ModelFieldType(ResolveNamespacedName(m.Namespace, string(field.Type), isType))
+		qualified.Fields[fieldName] = field
+	}
+
+	for _, relationName := range core.MapKeysSorted(qualified.Related) {
+		relation := qualified.Related[relationName]
+		relation.Aliased = qualifyRelationTarget(m.Namespace, relationName, relation.Aliased, isModel)
+		relation.For = qualifyNames(m.Namespace, relation.For, isModel)
+		qualified.Related[relationName] = relation
+	}
+	renameQualifiedRelations(qualified.Related)
+	return qualified
+}
+
+// QualifyNamespace returns the entity with its name, model paths and relation targets that name a definition of its namespace qualified
+func (e Entity) QualifyNamespace(isModel func(string) bool, isEntity func(string) bool) Entity {
+	if e.Namespace == "" && !hasQualifiedKeys(e.Related) {
+		return e
+	}
+	qualified := e.DeepClone()
+	qualified.Name = QualifyName(e.Namespace, e.Name)
+	for fieldName, field := range qualified.Fields {
+		field.Type = qualifyEntityFieldType(e.Namespace, field.Type, isModel)
+		qualified.Fields[fieldName] = field
+	}
+	for i, include := range qualified.Includes {
+		if modelPath, isValid := include.GetModelPath(); isValid {
+			include.From = qualifyModelFieldPath(e.Namespace, modelPath, isModel) + EntityIncludeWildcard
+		}
+		qualified.Includes[i] = include
+	}
+
+	for _, relationName := range core.MapKeysSorted(qualified.Related) {
+		relation := qualified.Related[relationName]
+		relation.Aliased = qualifyRelationTarget(e.Namespace, relationName, relation.Aliased, isEntity)
+		relation.For = qualifyNames(e.Namespace, relation.For, isEntity)
+		qualified.Related[relationName] = relation
+	}
+	renameQualifiedRelations(qualified.Related)
+	return qualified
+}
+
+// qualifyRelationTarget returns the aliased target of a relation, pointing it at a definition of the namespace if the relation name or alias names one
+func qualifyRelationTarget(namespace string, relationName string, aliased string, exists func(string) bool) string {
+	target := relationName
+	inverseSuffix := ""
+	if strings.TrimSpace(aliased) != "" {
+		target = GetAliasedTargetName(aliased)
+		inverseSuffix = strings.TrimPrefix(strings.TrimSpace(aliased), target)
+	}
+
+	qualifiedTarget := ResolveNamespacedName(namespace, target, exists)
+	if IsQualifiedName(relationName) && aliased == "" {
+		return relationName
+	}
+	if qualifiedTarget == relationName && aliased == "" {
+		return aliased
+	}
+	if qualifiedTarget == target && aliased != "" {
+		return aliased
+	}
+	return qualifiedTarget + inverseSuffix
+}
+
+func hasQualifiedKeys[TValue any](values map[string]TValue) bool {
+	for name := range values {
+		if IsQualifiedName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// renameQualifiedRelations renames relations such as 'billing.Invoice' to their local name, unless another relation already uses it
+func renameQualifiedRelations[TRelation any](allRelations map[string]TRelation) {
+	for _, relationName := range core.MapKeysSorted(allRelations) {
+		if !IsQualifiedName(relationName) {
+			continue
+		}
+		_, localName := SplitQualifiedName(relationName)
+		if _, exists := allRelations[localName]; exists {
+			continue
+		}
+		allRelations[localName] = allRelations[relationName]
+		delete(allRelations, relationName)
+	}
+}
+
+func qualifyNames(namespace string, names []string, exists func(string) bool) []string {
+	if len(names) == 0 {
+		return names
+	}
+	qualifiedNames := make([]string, len(names))
+	for i, name := range names {
+		qualifiedNames[i] = ResolveNamespacedName(namespace, name, exists)
+	}
+	return qualifiedNames
+}
+
+func qualifyModelFieldPath(namespace string, path ModelFieldPath, isModel func(string) bool) ModelFieldPath {
+	segments := SplitModelFieldPath(path)
+	segments[0] = ResolveNamespacedName(namespace, segments[0], isModel)
+	return ModelFieldPath(strings.Join(segments, "."))
+}
+
+// qualifyEntityFieldType qualifies the root models of all paths of an entity field type, unparsable types are left for validation to report
+func qualifyEntityFieldType(namespace string, fieldType ModelFieldPath, isModel func(string) bool) ModelFieldPath {
+	expression, parseErr := ParseEntityFieldExpression(fieldType)
+	if parseErr != nil {
+		return fieldType
+	}
+	changed := false
+	for i, argument := range expression.Arguments {
+		if argument.IsLiteral {
+			continue
+		}
+		qualifiedPath := qualifyModelFieldPath(namespace, argument.Path, isModel)
+		if qualifiedPath != argument.Path {
+			expression.Arguments[i].Path = qualifiedPath
+			changed = true
+		}
+	}
+	if !changed {
+		return fieldType
+	}
+	return ModelFieldPath(expression.String())
+}
@@ -0,0 +1,150 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isOneOf(names ...string) func(string) bool {
+	return func(name string) bool {
+		for _, candidate := range names {
+			if candidate == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestNamespace_QualifiedNames(t *testing.T) {
+	assert.True(t, IsValidNamespace("billing"))
+	assert.True(t, IsValidNamespace("billing.eu_west"))
+	assert.False(t, IsValidNamespace("Billing"))
+	assert.False(t, IsValidNamespace("billing..eu"))
+
+	assert.Equal(t, "billing.Invoice", QualifyName("billing", "Invoice"))
+	assert.Equal(t, "sales.Invoice", QualifyName("billing", "sales.Invoice"))
+	assert.Equal(t, "Invoice", QualifyName("", "Invoice"))
+
+	namespace, name := SplitQualifiedName("billing.eu.Invoice")
+	assert.Equal(t, "billing.eu", namespace)
+	assert.Equal(t, "Invoice", name)
+
+	exists := isOneOf("billing.Invoice")
+	assert.Equal(t, "billing.Invoice", ResolveNamespacedName("billing", "Invoice", exists))
+	assert.Equal(t, "Person", ResolveNamespacedName("billing", "Person", exists))
+}
+
+func TestNamespace_SplitPaths(t *testing.T) {
+	assert.Equal(t, []string{"billing.eu.Invoice", "Person", "Name"}, SplitModelFieldPath("billing.eu.Invoice.Person.Name"))
+	assert.Equal(t, []string{"Invoice", "Total"}, SplitModelFieldPath("Invoice.Total"))
+	assert.Equal(t, "billing.Comment", GetAliasedTargetName("billing.Comment.Commentable"))
+	assert.Equal(t, "Comment", GetAliasedTargetName("Comment.Commentable"))
+}
+
+func TestNamespace_DefinitionNames(t *testing.T) {
+	assert.True(t, IsValidDefinitionName("Person"))
+	assert.True(t, IsValidDefinitionName("billing.Invoice"))
+	assert.False(t, IsValidDefinitionName("person"))
+	assert.False(t, IsValidDefinitionName("billing.invoice"))
+	assert.False(t, IsValidDefinitionName("_Person"))
+
+	// a lowercase definition name would be read as a namespace of the path
+	assert.Equal(t, []string{"person.Company", "Name"}, SplitModelFieldPath("person.Company.Name"))
+
+	model := Model{
+		Name:        "person",
+		Fields:      map[string]ModelField{"ID": {Type: ModelFieldTypeAutoIncrement}},
+		Identifiers: map[string]ModelIdentifier{"primary": {Fields: []string{"ID"}}},
+	}
+	require.ErrorContains(t, model.Validate(nil), "morphe model name 'person' must start with a capital letter")
+
+	enum := Enum{Name: "status", Type: EnumTypeString, Entries: map[string]any{"Active": "active"}}
+	require.ErrorContains(t, enum.Validate(), "morphe enum name 'status' must start with a capital letter")
+
+	mixin := Mixin{Name: "billing.ledger", Includes: []string{"Record"}}
+	require.ErrorContains(t, mixin.Validate(), "morphe mixin name 'billing.ledger' must start with a capital letter")
+}
+
+func TestModel_QualifyNamespace(t *testing.T) {
+	model := Model{
+		Namespace: "billing",
+		Name:      "Invoice",
+		Fields: map[string]ModelField{
+			"Total":  {Type: "Money"},
+			"Status": {Type: "Status"},
+			"Note":   {Type: ModelFieldTypeString},
+		},
+		Related: map[string]ModelRelation{
+			"Person":       {Type: "ForOne"},
+			"LineItem":     {Type: "HasMany"},
+			"Owner":        {Type: "ForOne", Aliased: "Person"},
+			"sales.Order":  {Type: "ForOne"},
+			"Commentables": {Type: "HasManyPoly", Aliased: "Comment.Commentable"},
+		},
+	}
+
+	qualified := model.QualifyNamespace(isOneOf("billing.Money"), isOneOf("billing.LineItem", "billing.Comment", "sales.Order"))
+
+	assert.Equal(t, "billing.Invoice", qualified.Name)
+	assert.Equal(t, ModelFieldType("billing.Money"), qualified.Fields["Total"].Type)
+	assert.Equal(t, ModelFieldType("Status"), qualified.Fields["Status"].Type)
+	assert.Equal(t, []string{"Commentables", "LineItem", "Order", "Owner", "Person"}, core.MapKeysSorted(qualified.Related))
+	assert.Equal(t, "", qualified.Related["Person"].Aliased)
+	assert.Equal(t, "billing.LineItem", qualified.Related["LineItem"].Aliased)
+	assert.Equal(t, "Person", qualified.Related["Owner"].Aliased)
+	assert.Equal(t, "sales.Order", qualified.Related["Order"].Aliased)
+	assert.Equal(t, "billing.Comment.Commentable", qualified.Related["Commentables"].Aliased)
+	assert.Equal(t, "Invoice", model.Name)
+}
+
+func TestEntity_QualifyNamespace(t *testing.T) {
+	entity := Entity{
+		Namespace: "billing",
+		Name:      "Invoice",
+		Fields: map[string]EntityField{
+			"ID":         {Type: "Invoice.ID"},
+			"PersonName": {Type: "Invoice.Person.Name"},
+			"Label":      {Type: `concat(Invoice.Number, " - ", Person.Name)`},
+		},
+		Includes: EntityIncludes{{From: "Invoice.*"}},
+		Related: map[string]EntityRelation{
+			"Person": {Type: "ForOne"},
+		},
+	}
+
+	qualified := entity.QualifyNamespace(isOneOf("billing.Invoice"), isOneOf("billing.Invoice"))
+
+	assert.Equal(t, "billing.Invoice", qualified.Name)
+	assert.Equal(t, ModelFieldPath("billing.Invoice.ID"), qualified.Fields["ID"].Type)
+	assert.Equal(t, ModelFieldPath("billing.Invoice.Person.Name"), qualified.Fields["PersonName"].Type)
+	assert.Equal(t, ModelFieldPath(`concat(billing.Invoice.Number, " - ", Person.Name)`), qualified.Fields["Label"].Type)
+	assert.Equal(t, ModelFieldPath("billing.Invoice.*"), qualified.Includes[0].From)
+	assert.Equal(t, "", qualified.Related["Person"].Aliased)
+}
+
+func TestNamespace_UnqualifiedDefinitionsAreUnchanged(t *testing.T) {
+	enum := Enum{Name: "Status"}
+	assert.Equal(t, enum, enum.QualifyNamespace())
+
+	structure := Structure{Name: "Money", Fields: map[string]StructureField{"Currency": {Type: "Currency"}}}
+	assert.Equal(t, structure, structure.QualifyNamespace(isOneOf("Currency")))
+}
+
+func TestEntityFieldExpression_String(t *testing.T) {
+	for _, fieldType := range []ModelFieldPath{
+		"Invoice.Total",
+		"sum(Invoice.LineItem.Amount)",
+		`concat(Person.FirstName, " \"", Person.LastName)`,
+	} {
+		expression, parseErr := ParseEntityFieldExpression(fieldType)
+		require.NoError(t, parseErr)
+
+		reparsed, reparseErr := ParseEntityFieldExpression(ModelFieldPath(expression.String()))
+		require.NoError(t, reparseErr)
+		assert.Equal(t, expression, reparsed)
+	}
+}
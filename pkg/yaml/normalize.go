@@ -6,12 +6,29 @@ import "strings"
 func NormalizeEntity(e *Entity) {
 	// Normalize entity name
 	e.Name = strings.TrimSpace(e.Name)
+	e.Namespace = strings.TrimSpace(e.Namespace)
+	e.Deprecated = normalizeDeprecation(e.Deprecated)
+
+	// Normalize includes
+	for i, include := range e.Includes {
+		include.From = ModelFieldPath(strings.TrimSpace(string(include.From)))
+		include.Exclude = normalizeNames(include.Exclude)
+		if include.Rename != nil {
+			normalizedRename := make(map[string]string, len(include.Rename))
+			for fieldName, renamedTo := range include.Rename {
+				normalizedRename[strings.TrimSpace(fieldName)] = strings.TrimSpace(renamedTo)
+			}
+			include.Rename = normalizedRename
+		}
+		e.Includes[i] = include
+	}
 
 	// Normalize fields
 	normalizedFields := make(map[string]EntityField)
 	for fieldName, field := range e.Fields {
 		normalizedFieldName := strings.TrimSpace(fieldName)
 		field.Type = ModelFieldPath(strings.TrimSpace(string(field.Type)))
+		field.Deprecated = normalizeDeprecation(field.Deprecated)
 
 		// Normalize attributes
 		normalizedAttributes := make([]string, len(field.Attributes))
@@ -46,6 +63,7 @@ func NormalizeEntity(e *Entity) {
 		normalizedRelationName := strings.TrimSpace(relationName)
 		relation.Aliased = strings.TrimSpace(relation.Aliased)
 		relation.Through = strings.TrimSpace(relation.Through)
+		relation.Deprecated = normalizeDeprecation(relation.Deprecated)
 
 		// Normalize For field
 		normalizedFor := make([]string, len(relation.For))
@@ -63,12 +81,17 @@ func NormalizeEntity(e *Entity) {
 func NormalizeModel(m *Model) {
 	// Normalize model name
 	m.Name = strings.TrimSpace(m.Name)
+	m.Namespace = strings.TrimSpace(m.Namespace)
+	m.Deprecated = normalizeDeprecation(m.Deprecated)
+	m.Includes = normalizeNames(m.Includes)
 
 	// Normalize fields
 	normalizedFields := make(map[string]ModelField)
 	for fieldName, field := range m.Fields {
 		normalizedFieldName := strings.TrimSpace(fieldName)
 		field.Type = ModelFieldType(strings.TrimSpace(string(field.Type)))
+		field.RenamedFrom = strings.TrimSpace(field.RenamedFrom)
+		field.Deprecated = normalizeDeprecation(field.Deprecated)
 
 		// Normalize attributes
 		normalizedAttributes := make([]string, len(field.Attributes))
@@ -103,6 +126,11 @@ func NormalizeModel(m *Model) {
 		normalizedRelationName := strings.TrimSpace(relationName)
 		relation.Aliased = strings.TrimSpace(relation.Aliased)
 		relation.Through = strings.TrimSpace(relation.Through)
+		relation.OnDelete = ModelRelationOnDelete(strings.TrimSpace(string(relation.OnDelete)))
+		relation.ForeignKey = strings.TrimSpace(relation.ForeignKey)
+		relation.OrderBy.Field = strings.TrimSpace(relation.OrderBy.Field)
+		relation.OrderBy.Direction = ModelRelationOrderDirection(strings.TrimSpace(string(relation.OrderBy.Direction)))
+		relation.Deprecated = normalizeDeprecation(relation.Deprecated)
 
 		// Normalize For field
 		normalizedFor := make([]string, len(relation.For))
@@ -116,6 +144,37 @@ func NormalizeModel(m *Model) {
 	m.Related = normalizedRelations
 }
 
+// NormalizeMixin trims whitespace from string fields after unmarshaling
+func NormalizeMixin(m *Mixin) {
+	mixinModel := Model{Name: m.Name, Namespace: m.Namespace, Includes: m.Includes, Fields: m.Fields, Identifiers: m.Identifiers}
+	NormalizeModel(&mixinModel)
+
+	m.Name = mixinModel.Name
+	m.Namespace = mixinModel.Namespace
+	m.Includes = mixinModel.Includes
+	m.Fields = mixinModel.Fields
+	m.Identifiers = mixinModel.Identifiers
+}
+
+// NormalizeAllMixins applies normalization to all mixins
+func NormalizeAllMixins(mixins map[string]Mixin) {
+	for mixinName, mixin := range mixins {
+		NormalizeMixin(&mixin)
+		mixins[mixinName] = mixin
+	}
+}
+
+func normalizeNames(names []string) []string {
+	if names == nil {
+		return nil
+	}
+	normalizedNames := make([]string, len(names))
+	for i, name := range names {
+		normalizedNames[i] = strings.TrimSpace(name)
+	}
+	return normalizedNames
+}
+
 // NormalizeAllEntities applies normalization to all entities
 func NormalizeAllEntities(entities map[string]Entity) {
 	for entityName, entity := range entities {
@@ -136,9 +195,11 @@ func NormalizeAllModels(models map[string]Model) {
 func NormalizeEnum(e *Enum) {
 	// Normalize enum name
 	e.Name = strings.TrimSpace(e.Name)
+	e.Namespace = strings.TrimSpace(e.Namespace)
 
 	// Normalize enum type
 	e.Type = EnumType(strings.TrimSpace(string(e.Type)))
+	e.Kind = EnumKind(strings.TrimSpace(string(e.Kind)))
 
 	// Normalize enum entries (map keys)
 	normalizedEntries := make(map[string]any)
@@ -147,12 +208,41 @@ func NormalizeEnum(e *Enum) {
 		normalizedEntries[normalizedEntryName] = entryValue
 	}
 	e.Entries = normalizedEntries
+
+	if e.EntryOrder != nil {
+		normalizedEntryOrder := make([]string, len(e.EntryOrder))
+		for i, entryName := range e.EntryOrder {
+			normalizedEntryOrder[i] = strings.TrimSpace(entryName)
+		}
+		e.EntryOrder = normalizedEntryOrder
+	}
+	if e.EntryDetails != nil {
+		normalizedEntryDetails := make(map[string]EnumEntryDetails, len(e.EntryDetails))
+		for entryName, details := range e.EntryDetails {
+			normalizedEntryDetails[strings.TrimSpace(entryName)] = EnumEntryDetails{
+				Label:       strings.TrimSpace(details.Label),
+				Description: strings.TrimSpace(details.Description),
+			}
+		}
+		e.EntryDetails = normalizedEntryDetails
+	}
+
+	// Normalize deprecations
+	e.Deprecated = normalizeDeprecation(e.Deprecated)
+	if e.DeprecatedEntries != nil {
+		normalizedDeprecatedEntries := make(map[string]Deprecation, len(e.DeprecatedEntries))
+		for entryName, deprecation := range e.DeprecatedEntries {
+			normalizedDeprecatedEntries[strings.TrimSpace(entryName)] = *normalizeDeprecation(&deprecation)
+		}
+		e.DeprecatedEntries = normalizedDeprecatedEntries
+	}
 }
 
 // NormalizeStructure trims whitespace from string fields after unmarshaling
 func NormalizeStructure(s *Structure) {
 	// Normalize structure name
 	s.Name = strings.TrimSpace(s.Name)
+	s.Namespace = strings.TrimSpace(s.Namespace)
 
 	// Normalize fields
 	normalizedFields := make(map[string]StructureField)
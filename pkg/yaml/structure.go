@@ -5,14 +5,18 @@ import (
 )
 
 type Structure struct {
-	Name   string                    `yaml:"name"`
-	Fields map[string]StructureField `yaml:"fields"`
+	Name      string                    `yaml:"name"`
+	Namespace string                    `yaml:"namespace,omitempty"`
+	Fields    map[string]StructureField `yaml:"fields"`
 }
 
 func (s Structure) Validate(allEnums map[string]Enum) error {
 	if s.Name == "" {
 		return ErrNoMorpheStructureName
 	}
+	if !IsValidDefinitionName(s.Name) {
+		return ErrMorpheDefinitionNameNotCapitalized("structure", s.Name)
+	}
 	if len(s.Fields) == 0 {
 		return ErrNoMorpheStructureFields
 	}
@@ -30,8 +34,9 @@ func (s Structure) Validate(allEnums map[string]Enum) error {
 
 func (s Structure) DeepClone() Structure {
 	structureCopy := Structure{
-		Name:   s.Name,
-		Fields: clone.DeepCloneMap(s.Fields),
+		Name:      s.Name,
+		Namespace: s.Namespace,
+		Fields:    clone.DeepCloneMap(s.Fields),
 	}
 
 	return structureCopy
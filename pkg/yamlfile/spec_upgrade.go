@@ -0,0 +1,25 @@
+package yamlfile
+
+import yaml3 "gopkg.in/yaml.v3"
+
+// SpecUpgrade rewrites a decoded document from its From version to the next spec version.
+// It returns a warning for every deprecated construct it replaced.
+type SpecUpgrade struct {
+	From    SpecVersion
+	Upgrade func(document *yaml3.Node) ([]string, error)
+}
+
+// EnumSpecUpgrades upgrade older enum files to the current spec version
+var EnumSpecUpgrades = []SpecUpgrade{}
+
+// ModelSpecUpgrades upgrade older model files to the current spec version
+var ModelSpecUpgrades = []SpecUpgrade{}
+
+// StructureSpecUpgrades upgrade older structure files to the current spec version
+var StructureSpecUpgrades = []SpecUpgrade{}
+
+// EntitySpecUpgrades upgrade older entity files to the current spec version
+var EntitySpecUpgrades = []SpecUpgrade{}
+
+// MixinSpecUpgrades upgrade older mixin files to the current spec version
+var MixinSpecUpgrades = []SpecUpgrade{}
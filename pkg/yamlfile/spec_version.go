@@ -0,0 +1,37 @@
+package yamlfile
+
+// SpecVersionKey is the optional top level key that declares the spec version of a definition file
+const SpecVersionKey = "morphe"
+
+// SpecVersion is a version of the morphe definition file format
+type SpecVersion string
+
+// SpecVersion1 is the current format
+const SpecVersion1 SpecVersion = "1"
+
+// CurrentSpecVersion is the version decoded definitions are upgraded to
+const CurrentSpecVersion = SpecVersion1
+
+// SpecVersions lists all supported spec versions from oldest to newest
+var SpecVersions = []SpecVersion{
+	SpecVersion1,
+}
+
+// IsSupported returns true if the version is a known spec version
+func (v SpecVersion) IsSupported() bool {
+	return v.getIndex() >= 0
+}
+
+// IsOlderThan returns true if the version precedes the other version
+func (v SpecVersion) IsOlderThan(other SpecVersion) bool {
+	return v.getIndex() < other.getIndex()
+}
+
+func (v SpecVersion) getIndex() int {
+	for versionIdx, version := range SpecVersions {
+		if version == v {
+			return versionIdx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,7 @@
+package yamlfile
+
+import "fmt"
+
+func ErrUnsupportedSpecVersion(version SpecVersion) error {
+	return fmt.Errorf("unsupported morphe spec version '%s', supported versions are %v", version, SpecVersions)
+}
@@ -10,6 +10,11 @@ import (
 
 // UnmarshalAllYAMLFiles reads and unmarshals all YAML files in the specified directory with the specified suffix (including dot) as a map of the absolute file path to the target YAML container.
 func UnmarshalAllYAMLFiles[TTarget any](parentDirPath string, targetFileSuffix string) (map[string]TTarget, error) {
+	return unmarshalAllFiles(parentDirPath, targetFileSuffix, UnmarshalYAMLFile[TTarget])
+}
+
+// unmarshalAllFiles applies the unmarshal function to all files in the specified directory with the specified suffix
+func unmarshalAllFiles[TTarget any](parentDirPath string, targetFileSuffix string, unmarshal func(filePathAbs string, target *TTarget) error) (map[string]TTarget, error) {
 	dirEntries, readErr := os.ReadDir(parentDirPath)
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading directory '%s': %w", parentDirPath, readErr)
@@ -28,7 +33,7 @@ func UnmarshalAllYAMLFiles[TTarget any](parentDirPath string, targetFileSuffix s
 
 		var target TTarget
 		filePathAbs := filepath.Join(parentDirPath, targetFileName)
-		fileLoadErr := UnmarshalYAMLFile(filePathAbs, &target)
+		fileLoadErr := unmarshal(filePathAbs, &target)
 		if fileLoadErr != nil {
 			return nil, fileLoadErr
 		}
@@ -0,0 +1,149 @@
+package yamlfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// DecodeOptions configures the version aware decoding of definition files
+type DecodeOptions struct {
+	// DefaultSpecVersion applies to files without a 'morphe' key, the current version is used if empty
+	DefaultSpecVersion SpecVersion
+
+	// Upgrades are applied in order to files of an older spec version
+	Upgrades []SpecUpgrade
+}
+
+// DecodeWarning reports deprecated syntax found while decoding a file
+type DecodeWarning struct {
+	FilePath string
+	Message  string
+}
+
+func (w DecodeWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.FilePath, w.Message)
+}
+
+// UnmarshalAllVersionedYAMLFiles reads, upgrades and unmarshals all YAML files in the specified directory with the specified suffix (including dot)
+// as a map of the absolute file path to the target YAML container.
+func UnmarshalAllVersionedYAMLFiles[TTarget any](parentDirPath string, targetFileSuffix string, options DecodeOptions) (map[string]TTarget, []DecodeWarning, error) {
+	allWarnings := []DecodeWarning{}
+	allTargets, unmarshalErr := unmarshalAllFiles(parentDirPath, targetFileSuffix, func(filePathAbs string, target *TTarget) error {
+		warnings, fileLoadErr := UnmarshalVersionedYAMLFile(filePathAbs, target, options)
+		allWarnings = append(allWarnings, warnings...)
+		return fileLoadErr
+	})
+	if unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+	return allTargets, allWarnings, nil
+}
+
+// UnmarshalAllVersionedYAMLFilesInTree works like UnmarshalAllVersionedYAMLFiles, but also reads the files of all nested directories that are not hidden
+func UnmarshalAllVersionedYAMLFilesInTree[TTarget any](rootDirPath string, targetFileSuffix string, options DecodeOptions) (map[string]TTarget, []DecodeWarning, error) {
+	allTargets, allWarnings, rootErr := UnmarshalAllVersionedYAMLFiles[TTarget](rootDirPath, targetFileSuffix, options)
+	if rootErr != nil {
+		return nil, nil, rootErr
+	}
+
+	dirEntries, readErr := os.ReadDir(rootDirPath)
+	if readErr != nil {
+		return nil, nil, fmt.Errorf("error reading directory '%s': %w", rootDirPath, readErr)
+	}
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() || strings.HasPrefix(dirEntry.Name(), ".") {
+			continue
+		}
+		nestedTargets, nestedWarnings, nestedErr := UnmarshalAllVersionedYAMLFilesInTree[TTarget](filepath.Join(rootDirPath, dirEntry.Name()), targetFileSuffix, options)
+		if nestedErr != nil {
+			return nil, nil, nestedErr
+		}
+		for filePathAbs, target := range nestedTargets {
+			allTargets[filePathAbs] = target
+		}
+		allWarnings = append(allWarnings, nestedWarnings...)
+	}
+	return allTargets, allWarnings, nil
+}
+
+// UnmarshalVersionedYAMLFile reads the specified YAML file, upgrades it from its spec version to the current one and unmarshals it into the target YAML container.
+func UnmarshalVersionedYAMLFile[TTarget any](filePathAbs string, target *TTarget, options DecodeOptions) ([]DecodeWarning, error) {
+	fileContents, readFileErr := os.ReadFile(filePathAbs)
+	if readFileErr != nil {
+		return nil, fmt.Errorf("error reading file contents '%s': %w", filePathAbs, readFileErr)
+	}
+
+	var root yaml3.Node
+	unmarshalErr := yaml3.Unmarshal(fileContents, &root)
+	if unmarshalErr != nil {
+		return nil, fmt.Errorf("error unmarshalling yaml file contents '%s': %w", filePathAbs, unmarshalErr)
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+	document := root.Content[0]
+
+	messages, upgradeErr := upgradeDocument(document, options)
+	if upgradeErr != nil {
+		return nil, fmt.Errorf("error upgrading yaml file '%s': %w", filePathAbs, upgradeErr)
+	}
+
+	decodeErr := document.Decode(target)
+	if decodeErr != nil {
+		return nil, fmt.Errorf("error unmarshalling yaml file contents '%s': %w", filePathAbs, decodeErr)
+	}
+
+	warnings := make([]DecodeWarning, 0, len(messages))
+	for _, message := range messages {
+		warnings = append(warnings, DecodeWarning{FilePath: filePathAbs, Message: message})
+	}
+	return warnings, nil
+}
+
+// upgradeDocument removes the spec version key from the document and applies the upgrades from that version up to the current one
+func upgradeDocument(document *yaml3.Node, options DecodeOptions) ([]string, error) {
+	version, versionErr := popSpecVersion(document, options.DefaultSpecVersion)
+	if versionErr != nil {
+		return nil, versionErr
+	}
+
+	messages := []string{}
+	for _, upgrade := range options.Upgrades {
+		if upgrade.From.IsOlderThan(version) || !upgrade.From.IsOlderThan(CurrentSpecVersion) {
+			continue
+		}
+		upgradeMessages, upgradeErr := upgrade.Upgrade(document)
+		if upgradeErr != nil {
+			return nil, upgradeErr
+		}
+		messages = append(messages, upgradeMessages...)
+	}
+	return messages, nil
+}
+
+func popSpecVersion(document *yaml3.Node, defaultVersion SpecVersion) (SpecVersion, error) {
+	version := defaultVersion
+	if version == "" {
+		version = CurrentSpecVersion
+	}
+
+	if document.Kind == yaml3.MappingNode {
+		for entryIdx := 0; entryIdx+1 < len(document.Content); entryIdx += 2 {
+			if document.Content[entryIdx].Value != SpecVersionKey {
+				continue
+			}
+			version = SpecVersion(strings.TrimSpace(document.Content[entryIdx+1].Value))
+			document.Content = append(document.Content[:entryIdx], document.Content[entryIdx+2:]...)
+			break
+		}
+	}
+
+	if !version.IsSupported() {
+		return "", ErrUnsupportedSpecVersion(version)
+	}
+	return version, nil
+}
@@ -0,0 +1,88 @@
+package yamlfile_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml3 "gopkg.in/yaml.v3"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlfile"
+)
+
+func writeTestFile(t *testing.T, fileName string, contents string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	require.NoError(t, os.WriteFile(filePath, []byte(contents), 0644))
+	return filePath
+}
+
+func TestUnmarshalVersionedYAMLFile_CurrentVersion(t *testing.T) {
+	filePath := writeTestFile(t, "person.mod", "morphe: 1\nname: Person\nfields:\n  Name:\n    type: String\n")
+	options := yamlfile.DecodeOptions{Upgrades: yamlfile.ModelSpecUpgrades}
+
+	var model yaml.Model
+	warnings, unmarshalErr := yamlfile.UnmarshalVersionedYAMLFile(filePath, &model, options)
+
+	require.NoError(t, unmarshalErr)
+	assert.Empty(t, warnings)
+	assert.Equal(t, "Person", model.Name)
+	assert.Equal(t, yaml.ModelFieldTypeString, model.Fields["Name"].Type)
+}
+
+func TestUnmarshalVersionedYAMLFile_AppliesUpgrades(t *testing.T) {
+	legacyVersion := yamlfile.SpecVersion("0")
+	specVersions := yamlfile.SpecVersions
+	yamlfile.SpecVersions = append([]yamlfile.SpecVersion{legacyVersion}, specVersions...)
+	t.Cleanup(func() { yamlfile.SpecVersions = specVersions })
+
+	legacyFilePath := writeTestFile(t, "legacy.mod", "morphe: 0\nname: Person\n")
+	currentFilePath := writeTestFile(t, "current.mod", "morphe: 1\nname: Person\n")
+	renameUpgrade := yamlfile.SpecUpgrade{
+		From: legacyVersion,
+		Upgrade: func(document *yaml3.Node) ([]string, error) {
+			document.Content[1].Value = "Human"
+			return []string{"name was upgraded"}, nil
+		},
+	}
+	currentUpgrade := yamlfile.SpecUpgrade{
+		From: yamlfile.CurrentSpecVersion,
+		Upgrade: func(document *yaml3.Node) ([]string, error) {
+			return nil, errors.New("current files must not be upgraded")
+		},
+	}
+	options := yamlfile.DecodeOptions{Upgrades: []yamlfile.SpecUpgrade{renameUpgrade, currentUpgrade}}
+
+	var legacyModel yaml.Model
+	legacyWarnings, legacyErr := yamlfile.UnmarshalVersionedYAMLFile(legacyFilePath, &legacyModel, options)
+	var currentModel yaml.Model
+	currentWarnings, currentErr := yamlfile.UnmarshalVersionedYAMLFile(currentFilePath, &currentModel, options)
+
+	require.NoError(t, legacyErr)
+	require.NoError(t, currentErr)
+	assert.Equal(t, "Human", legacyModel.Name)
+	assert.Equal(t, []yamlfile.DecodeWarning{{FilePath: legacyFilePath, Message: "name was upgraded"}}, legacyWarnings)
+	assert.Equal(t, "Person", currentModel.Name)
+	assert.Empty(t, currentWarnings)
+}
+
+func TestUnmarshalVersionedYAMLFile_DefaultVersion(t *testing.T) {
+	filePath := writeTestFile(t, "person.mod", "name: Person\n")
+
+	var model yaml.Model
+	_, unmarshalErr := yamlfile.UnmarshalVersionedYAMLFile(filePath, &model, yamlfile.DecodeOptions{DefaultSpecVersion: "9"})
+
+	assert.ErrorContains(t, unmarshalErr, "unsupported morphe spec version '9'")
+}
+
+func TestUnmarshalVersionedYAMLFile_UnsupportedVersion(t *testing.T) {
+	filePath := writeTestFile(t, "person.mod", "morphe: 9\nname: Person\n")
+
+	var model yaml.Model
+	_, unmarshalErr := yamlfile.UnmarshalVersionedYAMLFile(filePath, &model, yamlfile.DecodeOptions{})
+
+	assert.ErrorContains(t, unmarshalErr, "unsupported morphe spec version '9'")
+}
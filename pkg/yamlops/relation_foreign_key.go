@@ -0,0 +1,43 @@
+package yamlops
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+// GetForRelationForeignKey returns the foreign key field a For relation holds, which defaults to '<RelationName>ID' without the namespace
+func GetForRelationForeignKey(relationName string, relation yaml.ModelRelation) string {
+	if relation.ForeignKey != "" {
+		return relation.ForeignKey
+	}
+	return getDefaultForeignKey(relationName)
+}
+
+// GetHasRelationForeignKey returns the foreign key field a Has relation expects on its target model.
+// It is taken from the relation, then from the inverse For relation of the target model, and defaults to '<ModelName>ID' without the namespace.
+// Several inverse For relations make the foreign key ambiguous, the relation must then set it explicitly.
+func GetHasRelationForeignKey(modelName string, relation yaml.ModelRelation, targetModel yaml.Model) (string, error) {
+	if relation.ForeignKey != "" {
+		return relation.ForeignKey, nil
+	}
+	inverseNames := []string{}
+	for _, inverseName := range core.MapKeysSorted(targetModel.Related) {
+		inverse := targetModel.Related[inverseName]
+		if IsRelationFor(inverse.Type) && !IsRelationPoly(inverse.Type) && GetRelationTargetName(inverseName, inverse.Aliased) == modelName {
+			inverseNames = append(inverseNames, inverseName)
+		}
+	}
+
+	switch len(inverseNames) {
+	case 0:
+		return getDefaultForeignKey(modelName), nil
+	case 1:
+		return GetForRelationForeignKey(inverseNames[0], targetModel.Related[inverseNames[0]]), nil
+	}
+	return "", ErrAmbiguousHasRelationForeignKey(modelName, targetModel.Name, inverseNames)
+}
+
+func getDefaultForeignKey(name string) string {
+	_, localName := yaml.SplitQualifiedName(name)
+	return localName + "ID"
+}
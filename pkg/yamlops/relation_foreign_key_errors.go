@@ -0,0 +1,7 @@
+package yamlops
+
+import "fmt"
+
+func ErrAmbiguousHasRelationForeignKey(modelName string, targetModelName string, inverseNames []string) error {
+	return fmt.Errorf("model '%s' Has relation to '%s' has an ambiguous foreign key, the For relations %v all point back, set its foreignKey", modelName, targetModelName, inverseNames)
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
 )
 
 func TestIsRelationFor(t *testing.T) {
@@ -139,3 +141,50 @@ func TestGetRelationTargetName(t *testing.T) {
 	// When aliased has whitespace, should trim and use aliased
 	assert.Equal(t, "ContactInfo", GetRelationTargetName("WorkContact", " ContactInfo "))
 }
+
+func TestGetForRelationForeignKey(t *testing.T) {
+	assert.Equal(t, "CompanyID", GetForRelationForeignKey("Company", yaml.ModelRelation{Type: "ForOne"}))
+	assert.Equal(t, "EmployerRef", GetForRelationForeignKey("Employer", yaml.ModelRelation{Type: "ForOne", ForeignKey: "EmployerRef"}))
+	assert.Equal(t, "InvoiceID", GetForRelationForeignKey("billing.Invoice", yaml.ModelRelation{Type: "ForOne"}))
+}
+
+func TestGetHasRelationForeignKey(t *testing.T) {
+	personModel := yaml.Model{
+		Name: "Person",
+		Related: map[string]yaml.ModelRelation{
+			"Employer": {Type: "ForOne", Aliased: "Company"},
+			"Tag":      {Type: "ForOnePoly", For: []string{"Company"}},
+		},
+	}
+
+	inverseKey, inverseErr := GetHasRelationForeignKey("Company", yaml.ModelRelation{Type: "HasMany", Aliased: "Person"}, personModel)
+	explicitKey, explicitErr := GetHasRelationForeignKey("Company", yaml.ModelRelation{Type: "HasMany", ForeignKey: "OwnerID"}, personModel)
+	defaultKey, defaultErr := GetHasRelationForeignKey("Project", yaml.ModelRelation{Type: "HasMany"}, personModel)
+	namespacedKey, namespacedErr := GetHasRelationForeignKey("billing.Invoice", yaml.ModelRelation{Type: "HasMany"}, personModel)
+
+	assert.NoError(t, inverseErr)
+	assert.NoError(t, explicitErr)
+	assert.NoError(t, defaultErr)
+	assert.NoError(t, namespacedErr)
+	assert.Equal(t, "EmployerID", inverseKey)
+	assert.Equal(t, "OwnerID", explicitKey)
+	assert.Equal(t, "ProjectID", defaultKey)
+	assert.Equal(t, "InvoiceID", namespacedKey)
+}
+
+func TestGetHasRelationForeignKey_AmbiguousInverse(t *testing.T) {
+	personModel := yaml.Model{
+		Name: "Person",
+		Related: map[string]yaml.ModelRelation{
+			"Employer":       {Type: "ForOne", Aliased: "Company"},
+			"FormerEmployer": {Type: "ForOne", Aliased: "Company"},
+		},
+	}
+
+	_, ambiguousErr := GetHasRelationForeignKey("Company", yaml.ModelRelation{Type: "HasMany", Aliased: "Person"}, personModel)
+	explicitKey, explicitErr := GetHasRelationForeignKey("Company", yaml.ModelRelation{Type: "HasMany", Aliased: "Person", ForeignKey: "FormerEmployerID"}, personModel)
+
+	assert.ErrorContains(t, ambiguousErr, "model 'Company' Has relation to 'Person' has an ambiguous foreign key, the For relations [Employer FormerEmployer] all point back, set its foreignKey")
+	assert.NoError(t, explicitErr)
+	assert.Equal(t, "FormerEmployerID", explicitKey)
+}
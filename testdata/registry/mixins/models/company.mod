@@ -0,0 +1,9 @@
+name: Company
+includes:
+  - Keys
+fields:
+  Name:
+    type: String
+related:
+  Person:
+    type: HasMany
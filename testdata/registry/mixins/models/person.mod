@@ -0,0 +1,15 @@
+name: Person
+includes:
+  - Record
+fields:
+  FirstName:
+    type: String
+  LastName:
+    type: String
+identifiers:
+  name:
+    - FirstName
+    - LastName
+related:
+  Company:
+    type: ForOne
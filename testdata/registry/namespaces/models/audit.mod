@@ -0,0 +1,13 @@
+namespace: audit
+name: Entry
+fields:
+  ID:
+    type: AutoIncrement
+  Message:
+    type: String
+identifiers:
+  primary: ID
+related:
+  Author:
+    type: ForOne
+    aliased: Person
@@ -0,0 +1,11 @@
+name: Invoice
+includes:
+  - Ledger
+fields:
+  Total:
+    type: Money
+  Currency:
+    type: Currency
+related:
+  Person:
+    type: ForOne
@@ -0,0 +1,11 @@
+name: Person
+fields:
+  ID:
+    type: AutoIncrement
+  Name:
+    type: String
+identifiers:
+  primary: ID
+related:
+  billing.Invoice:
+    type: HasMany
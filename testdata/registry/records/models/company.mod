@@ -0,0 +1,24 @@
+name: Company
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  Name:
+    type: String
+    attributes:
+      - mandatory
+  OwnerID:
+    type: Integer
+identifiers:
+  primary: ID
+  name: Name
+related:
+  Employee:
+    type: HasMany
+    aliased: Person
+  Office:
+    type: HasOne
+  Owner:
+    type: ForOne
+    aliased: Person
@@ -0,0 +1,19 @@
+name: ContactInfo
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  Email:
+    type: String
+    attributes:
+      - mandatory
+  PersonID:
+    type: Integer
+identifiers:
+  primary: ID
+  email: Email
+related:
+  Person:
+    type: ForOne
+    onDelete: setNull
@@ -0,0 +1,15 @@
+name: Membership
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  Level:
+    type: Level
+  Active:
+    type: Boolean
+identifiers:
+  primary: ID
+  tier:
+    - Level
+    - Active
@@ -0,0 +1,17 @@
+name: Office
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  ZipCode:
+    type: String
+  City:
+    type: String
+  CompanyID:
+    type: Integer
+identifiers:
+  primary: ID
+related:
+  Company:
+    type: ForOne
@@ -0,0 +1,15 @@
+name: Order
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  Total:
+    type: Float
+  PersonID:
+    type: Integer
+identifiers:
+  primary: ID
+related:
+  Person:
+    type: ForOne
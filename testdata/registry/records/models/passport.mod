@@ -0,0 +1,13 @@
+name: Passport
+fields:
+  Number:
+    type: String
+    attributes:
+      - mandatory
+  PersonID:
+    type: Integer
+identifiers:
+  primary: Number
+related:
+  Person:
+    type: ForOne
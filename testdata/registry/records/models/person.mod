@@ -0,0 +1,63 @@
+name: Person
+fields:
+  ID:
+    type: AutoIncrement
+    attributes:
+      - mandatory
+  UUID:
+    type: UUID
+    attributes:
+      - immutable
+      - mandatory
+  FirstName:
+    type: String
+    attributes:
+      - mandatory
+  LastName:
+    type: String
+  Age:
+    type: Integer
+  Salary:
+    type: Float
+  Active:
+    type: Boolean
+  BirthDate:
+    type: Date
+  CreatedAt:
+    type: Time
+  Nationality:
+    type: Nationality
+  Level:
+    type: Level
+  Address:
+    type: Address
+  Password:
+    type: Protected
+  SSN:
+    type: Sealed
+  Credentials:
+    type: Credentials
+  EmployerID:
+    type: Integer
+  MentorID:
+    type: Integer
+identifiers:
+  primary: ID
+  name:
+    - FirstName
+    - LastName
+related:
+  Employer:
+    type: ForOne
+    aliased: Company
+    required: true
+    onDelete: cascade
+  Mentor:
+    type: ForOne
+    aliased: Person
+  ContactInfo:
+    type: HasOne
+  Order:
+    type: HasMany
+  Passport:
+    type: HasOne